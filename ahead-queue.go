@@ -0,0 +1,153 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+// aheadRune is the struct that holds a rune which has been read but is not
+// processed yet because the line break opportunity before it is not
+// determined.
+type aheadRune struct {
+	r     rune
+	src   srcPos
+	esc   bool /* whether the rune is in an escape sequence */
+	ended bool /* whether the rune ends the escape sequence */
+}
+
+// aheadQueue is the struct that holds the runes which have been read but are
+// not processed yet, and the line break opportunities before the runes which
+// are not in escape sequences.
+// The runes are consumed by advancing the head indexes, and the consumed runes
+// are removed when the queue becomes empty or they are the greater part of it.
+// In the link aware mode, the queue also finds the ends of tokens
+// incrementally.
+type aheadQueue struct {
+	runes    []aheadRune
+	brks     []brkType
+	head     int  /* index of the first rune which is not processed */
+	brkHead  int  /* index of the line break opportunity before the rune */
+	scan     int  /* index of the first rune not checked for a token end */
+	scanBrk  int  /* index of the line break opportunity before the rune */
+	tokenEnd int  /* index next to the last rune found which ends a token */
+	eot      bool /* whether the last rune of the text has been read */
+}
+
+func (q *aheadQueue) reset() {
+	q.runes = q.runes[:0]
+	q.brks = q.brks[:0]
+	q.head = 0
+	q.brkHead = 0
+	q.scan = 0
+	q.scanBrk = 0
+	q.tokenEnd = 0
+	q.eot = false
+}
+
+func (q *aheadQueue) push(a aheadRune) {
+	q.runes = append(q.runes, a)
+}
+
+func (q *aheadQueue) isEmpty() bool {
+	return q.head >= len(q.runes)
+}
+
+// first is the method that returns the first rune which is not processed, and
+// whether the line break opportunity before it is determined.
+// The line break opportunity of a rune in an escape sequence is always
+// regarded as determined.
+func (q *aheadQueue) first() (aheadRune, bool) {
+	a := q.runes[q.head]
+	return a, a.esc || q.brkHead < len(q.brks)
+}
+
+// firstBrk is the method that returns the line break opportunity before the
+// first rune which is not processed.
+func (q *aheadQueue) firstBrk() brkType {
+	return q.brks[q.brkHead]
+}
+
+// pop is the method to remove the first rune which is not processed.
+func (q *aheadQueue) pop() {
+	if !q.runes[q.head].esc {
+		q.brkHead++
+	}
+	q.head++
+
+	switch {
+	case q.head >= len(q.runes):
+		q.compact(q.head, q.brkHead)
+	case q.head > 1024 && q.head*2 > len(q.runes):
+		q.compact(q.head, q.brkHead)
+	}
+}
+
+// compact is the method to remove the processed runes and line break
+// opportunities before the specified indexes.
+func (q *aheadQueue) compact(n, nBrk int) {
+	q.runes = q.runes[:copy(q.runes, q.runes[n:])]
+	q.brks = q.brks[:copy(q.brks, q.brks[nBrk:])]
+	q.head -= n
+	q.brkHead -= nBrk
+	q.scan -= n
+	q.scanBrk -= nBrk
+	q.tokenEnd -= n
+	if q.scan < 0 {
+		q.scan, q.scanBrk = 0, 0
+	}
+	if q.tokenEnd < 0 {
+		q.tokenEnd = 0
+	}
+}
+
+// tokenRead is the method that returns true if the token including the first
+// rune which is not processed has been read to its end, or the runes read
+// ahead reach maxTokenLookahead.
+// Each rune is checked only once whether it ends a token.
+func (q *aheadQueue) tokenRead() bool {
+	if q.eot || q.tokenEnd > q.head {
+		return true
+	}
+	if q.scan < q.head {
+		q.scan, q.scanBrk = q.head, q.brkHead
+	}
+	for ; q.scan < len(q.runes); q.scan++ {
+		a := q.runes[q.scan]
+		if a.esc {
+			continue
+		}
+		if q.scanBrk >= len(q.brks) {
+			break
+		}
+		brk := q.brks[q.scanBrk]
+		q.scanBrk++
+		if isTokenEnd(a.r, brk) {
+			q.scan++
+			q.tokenEnd = q.scan
+			return true
+		}
+	}
+	return len(q.runes)-q.head > maxTokenLookahead
+}
+
+// token is the method to append the runes following the rune being processed
+// up to the end of the token, to the specified slice, and returns it.
+// The runes are at most maxTokenLookahead.
+func (q *aheadQueue) token(runes []rune) []rune {
+	k := q.brkHead
+	for i := q.head; i < len(q.runes) && len(runes) < maxTokenLookahead; i++ {
+		a := q.runes[i]
+		if a.esc {
+			continue
+		}
+		if k >= len(q.brks) {
+			break
+		}
+		brk := q.brks[k]
+		k++
+		if isTokenEnd(a.r, brk) {
+			break
+		}
+		runes = append(runes, a.r)
+	}
+	return runes
+}
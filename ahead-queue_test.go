@@ -0,0 +1,93 @@
+package linebreak
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAheadQueue_popAndCompact(t *testing.T) {
+	q := aheadQueue{}
+	q.reset()
+	assert.True(t, q.isEmpty())
+
+	q.push(aheadRune{r: 'a'})
+	q.brks = append(q.brks, brk_never)
+	q.push(aheadRune{r: 0x1b, esc: true})
+	q.push(aheadRune{r: 'b'})
+
+	a, ok := q.first()
+	assert.Equal(t, a.r, 'a')
+	assert.True(t, ok)
+	assert.Equal(t, q.firstBrk(), brk_never)
+	q.pop()
+
+	a, ok = q.first()
+	assert.Equal(t, a.r, rune(0x1b))
+	assert.True(t, ok)
+	q.pop()
+
+	a, ok = q.first()
+	assert.Equal(t, a.r, 'b')
+	assert.False(t, ok)
+	q.brks = append(q.brks, brk_allowed)
+	_, ok = q.first()
+	assert.True(t, ok)
+	assert.Equal(t, q.firstBrk(), brk_allowed)
+	q.pop()
+
+	assert.True(t, q.isEmpty())
+	assert.Equal(t, len(q.runes), 0)
+	assert.Equal(t, len(q.brks), 0)
+	assert.Equal(t, q.head, 0)
+	assert.Equal(t, q.brkHead, 0)
+}
+
+func TestAheadQueue_compactWhenHeadIsFar(t *testing.T) {
+	q := aheadQueue{}
+	q.reset()
+	for i := 0; i < 3000; i++ {
+		q.push(aheadRune{r: 'a'})
+		q.brks = append(q.brks, brk_never)
+	}
+	for i := 0; i < 1500; i++ {
+		q.pop()
+	}
+	assert.Equal(t, q.head, 1500)
+	assert.Equal(t, len(q.runes), 3000)
+
+	q.pop()
+	assert.Equal(t, q.head, 0)
+	assert.Equal(t, q.brkHead, 0)
+	assert.Equal(t, len(q.runes), 1499)
+	assert.Equal(t, len(q.brks), 1499)
+}
+
+func TestAheadQueue_tokenRead(t *testing.T) {
+	q := aheadQueue{}
+	q.reset()
+	for _, r := range "ab" {
+		q.push(aheadRune{r: r})
+		q.brks = append(q.brks, brk_never)
+	}
+	assert.False(t, q.tokenRead())
+	assert.Equal(t, q.token([]rune{'x'}), []rune("xab"))
+
+	q.push(aheadRune{r: ' '})
+	assert.False(t, q.tokenRead())
+	q.brks = append(q.brks, brk_space)
+	assert.True(t, q.tokenRead())
+	assert.Equal(t, q.token([]rune{'x'}), []rune("xab"))
+
+	q.pop()
+	q.pop()
+	assert.True(t, q.tokenRead())
+	q.pop()
+	assert.True(t, q.isEmpty())
+
+	q.push(aheadRune{r: 'c'})
+	q.brks = append(q.brks, brk_never)
+	assert.False(t, q.tokenRead())
+	q.eot = true
+	assert.True(t, q.tokenRead())
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Alignment is the enum type for the alignment of output lines.
+type Alignment int
+
+const (
+	// AlignLeft is the alignment which puts the lines at the left end.
+	// This is the default alignment.
+	AlignLeft Alignment = iota
+
+	// AlignRight is the alignment which puts the lines at the right end.
+	AlignRight
+
+	// AlignCenter is the alignment which puts the lines at the center.
+	AlignCenter
+
+	// AlignJustify is the alignment which puts the lines at both ends by
+	// distributing extra spaces between words. A line without spaces, such as
+	// a line of Japanese text, is justified by spacing between East Asian
+	// letters. The last line of each paragraph is aligned at the left end.
+	AlignJustify
+)
+
+// alignLine is the function that aligns the content of a line, which consists
+// of the units of runes before the end index, within the specified width.
+// This function returns the padding put before the content, the content and
+// the padding put after the content.
+func alignLine(
+	runes []rune, widths []int, end int, lineWidth int,
+	align Alignment, endsParagraph, padsRight bool,
+) (string, []rune, string) {
+	content := runes[0:end]
+
+	w := 0
+	for i := 0; i < end; i++ {
+		if widths[i] > 0 {
+			w += widths[i]
+		}
+	}
+	extra := lineWidth - w
+	if extra <= 0 {
+		return "", content, ""
+	}
+
+	var left, right int
+	switch align {
+	case AlignRight:
+		left = extra
+	case AlignCenter:
+		left = extra / 2
+		right = extra - left
+	case AlignJustify:
+		if !endsParagraph {
+			if justified, ok := justify(runes, widths, end, extra); ok {
+				return "", justified, ""
+			}
+		}
+		right = extra
+	default:
+		return "", content, ""
+	}
+
+	if !padsRight {
+		right = 0
+	}
+	return strings.Repeat(" ", left), content, strings.Repeat(" ", right)
+}
+
+// justify is the function that distributes the extra width to the gaps
+// between words, or to the gaps next to East Asian letters if there is no
+// space in the line.
+func justify(runes []rune, widths []int, end int, extra int) ([]rune, bool) {
+	gaps := make([]int, 0, 8)
+
+	prevVisible := -1
+	afterSpace := false
+	for i := 0; i < end; i++ {
+		if widths[i] <= 0 {
+			continue
+		}
+		if unicode.IsSpace(runes[i]) {
+			afterSpace = true
+			continue
+		}
+		if afterSpace && prevVisible >= 0 {
+			gaps = append(gaps, i)
+		}
+		afterSpace = false
+		prevVisible = i
+	}
+
+	if len(gaps) == 0 {
+		prevVisible = -1
+		for i := 0; i < end; i++ {
+			if widths[i] <= 0 {
+				continue
+			}
+			if prevVisible >= 0 &&
+				(isEastAsian(runes[prevVisible]) || isEastAsian(runes[i])) {
+				gaps = append(gaps, i)
+			}
+			prevVisible = i
+		}
+	}
+
+	if len(gaps) == 0 {
+		return nil, false
+	}
+
+	line := make([]rune, 0, end+extra)
+	start := 0
+	for n, gap := range gaps {
+		line = append(line, runes[start:gap]...)
+		sp := extra / len(gaps)
+		if n < extra%len(gaps) {
+			sp++
+		}
+		for k := 0; k < sp; k++ {
+			line = append(line, ' ')
+		}
+		start = gap
+	}
+	line = append(line, runes[start:end]...)
+	return line, true
+}
@@ -0,0 +1,342 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"strconv"
+	"strings"
+)
+
+// State of escSeqState
+type escSeqType int
+
+const (
+	esc_none   escSeqType = iota
+	esc_escape            // after ESC
+	esc_nf                // in an nF sequence, such as a character set designation
+	esc_csi               // in a CSI sequence
+	esc_str               // in a control string, such as an OSC sequence
+	esc_strEsc            // after ESC in a control string
+)
+
+// escSeqState is the struct that recognizes ANSI escape sequences (ECMA-48),
+// such as CSI sequences for SGR colors and OSC sequences for hyperlinks.
+// This struct receives runes one by one and returns whether the received rune
+// is a part of an escape sequence and whether the sequence ends with it.
+type escSeqState struct {
+	state escSeqType
+}
+
+func (s *escSeqState) reset() {
+	s.state = esc_none
+}
+
+func (s *escSeqState) next(r rune) (inSeq bool, ended bool) {
+	switch s.state {
+	case esc_none:
+		switch r {
+		case 0x1b: // ESC
+			s.state = esc_escape
+		case 0x9b: // CSI
+			s.state = esc_csi
+		case 0x90, 0x98, 0x9d, 0x9e, 0x9f: // DCS, SOS, OSC, PM, APC
+			s.state = esc_str
+		default:
+			return false, false
+		}
+		return true, false
+
+	case esc_escape:
+		switch r {
+		case '[':
+			s.state = esc_csi
+		case ']', 'P', 'X', '^', '_':
+			s.state = esc_str
+		default:
+			if 0x20 <= r && r <= 0x2f { // intermediate bytes
+				s.state = esc_nf
+				return true, false
+			}
+			s.state = esc_none
+			return true, true
+		}
+		return true, false
+
+	case esc_nf:
+		if 0x20 <= r && r <= 0x2f { // intermediate bytes
+			return true, false
+		}
+		s.state = esc_none
+		return true, true
+
+	case esc_csi:
+		if 0x20 <= r && r <= 0x3f { // parameter or intermediate bytes
+			return true, false
+		}
+		s.state = esc_none
+		return true, true
+
+	case esc_str:
+		switch r {
+		case 0x07, 0x9c: // BEL, ST
+			s.state = esc_none
+			return true, true
+		case 0x1b:
+			s.state = esc_strEsc
+		}
+		return true, false
+
+	default: // esc_strEsc
+		s.state = esc_none
+		return true, true
+	}
+}
+
+const (
+	sgrReset  = "\x1b[0m"
+	linkClose = "\x1b]8;;\x1b\\"
+)
+
+// Attributes of SGR which are independent of each other.
+const (
+	sgr_bold      = iota // 1, reset by 22
+	sgr_faint            // 2, reset by 22
+	sgr_italic           // 3 or 20 (Fraktur), reset by 23
+	sgr_underline        // 4 or 21 (double), reset by 24
+	sgr_blink            // 5 or 6, reset by 25
+	sgr_spacing          // 26, reset by 50
+	sgr_inverse          // 7, reset by 27
+	sgr_conceal          // 8, reset by 28
+	sgr_strike           // 9, reset by 29
+	sgr_font             // 11 - 19, reset by 10
+	sgr_fg               // 30 - 38 or 90 - 97, reset by 39
+	sgr_bg               // 40 - 48 or 100 - 107, reset by 49
+	sgr_frame            // 51 or 52, reset by 54
+	sgr_overline         // 53, reset by 55
+	sgr_ulColor          // 58, reset by 59
+	sgr_ideogram         // 60 - 64, reset by 65
+	sgr_script           // 73 or 74, reset by 75
+	sgr_count
+)
+
+// sgrState is the struct that holds the SGR attributes and the OSC 8
+// hyperlink which are active at a point in a text.
+// Each attribute holds the SGR parameter which sets it, or an empty string if
+// it is not set, so that the attributes are restored with a single SGR.
+type sgrState struct {
+	attrs [sgr_count]string
+	link  string
+}
+
+func (s *sgrState) reset() {
+	s.attrs = [sgr_count]string{}
+	s.link = ""
+}
+
+// update is the method to update the active attributes with the specified
+// escape sequence.
+func (s *sgrState) update(seq string) {
+	if params, ok := sgrParams(seq); ok {
+		s.apply(strings.Split(params, ";"))
+		return
+	}
+
+	if uri, ok := hyperlinkURI(seq); ok {
+		if uri == "" {
+			s.link = ""
+		} else {
+			s.link = seq
+		}
+	}
+}
+
+// apply is the method to set or reset the attributes by the specified SGR
+// parameters in order.
+// A parameter can have sub-parameters separated by colons, such as "4:3" and
+// "38:2::255:0:0".
+func (s *sgrState) apply(params []string) {
+	for i := 0; i < len(params); i++ {
+		param := params[i]
+		code := param
+		if j := strings.IndexByte(param, ':'); j >= 0 {
+			code = param[0:j]
+		}
+		n := 0
+		if len(code) > 0 {
+			var err error
+			if n, err = strconv.Atoi(code); err != nil {
+				continue
+			}
+		}
+
+		switch {
+		case n == 0:
+			s.attrs = [sgr_count]string{}
+		case n == 1:
+			s.attrs[sgr_bold] = param
+		case n == 2:
+			s.attrs[sgr_faint] = param
+		case n == 22:
+			s.attrs[sgr_bold] = ""
+			s.attrs[sgr_faint] = ""
+		case n == 3, n == 20:
+			s.attrs[sgr_italic] = param
+		case n == 23:
+			s.attrs[sgr_italic] = ""
+		case n == 4, n == 21:
+			s.attrs[sgr_underline] = param
+		case n == 24:
+			s.attrs[sgr_underline] = ""
+		case n == 5, n == 6:
+			s.attrs[sgr_blink] = param
+		case n == 25:
+			s.attrs[sgr_blink] = ""
+		case n == 26:
+			s.attrs[sgr_spacing] = param
+		case n == 50:
+			s.attrs[sgr_spacing] = ""
+		case n == 7:
+			s.attrs[sgr_inverse] = param
+		case n == 27:
+			s.attrs[sgr_inverse] = ""
+		case n == 8:
+			s.attrs[sgr_conceal] = param
+		case n == 28:
+			s.attrs[sgr_conceal] = ""
+		case n == 9:
+			s.attrs[sgr_strike] = param
+		case n == 29:
+			s.attrs[sgr_strike] = ""
+		case 11 <= n && n <= 19:
+			s.attrs[sgr_font] = param
+		case n == 10:
+			s.attrs[sgr_font] = ""
+		case 30 <= n && n <= 37, 90 <= n && n <= 97:
+			s.attrs[sgr_fg] = param
+		case n == 39:
+			s.attrs[sgr_fg] = ""
+		case 40 <= n && n <= 47, 100 <= n && n <= 107:
+			s.attrs[sgr_bg] = param
+		case n == 49:
+			s.attrs[sgr_bg] = ""
+		case n == 38, n == 48, n == 58:
+			// the color is specified by the following parameters, as
+			// "38;5;n" and "38;2;r;g;b", unless it has sub-parameters.
+			if param == code && i+1 < len(params) {
+				k := 0
+				switch params[i+1] {
+				case "5":
+					k = 2
+				case "2":
+					k = 4
+				}
+				if i+1+k > len(params) {
+					k = len(params) - i - 1
+				}
+				param = strings.Join(params[i:i+1+k], ";")
+				i += k
+			}
+			switch n {
+			case 38:
+				s.attrs[sgr_fg] = param
+			case 48:
+				s.attrs[sgr_bg] = param
+			default:
+				s.attrs[sgr_ulColor] = param
+			}
+		case n == 59:
+			s.attrs[sgr_ulColor] = ""
+		case n == 51, n == 52:
+			s.attrs[sgr_frame] = param
+		case n == 54:
+			s.attrs[sgr_frame] = ""
+		case n == 53:
+			s.attrs[sgr_overline] = param
+		case n == 55:
+			s.attrs[sgr_overline] = ""
+		case 60 <= n && n <= 64:
+			s.attrs[sgr_ideogram] = param
+		case n == 65:
+			s.attrs[sgr_ideogram] = ""
+		case n == 73, n == 74:
+			s.attrs[sgr_script] = param
+		case n == 75:
+			s.attrs[sgr_script] = ""
+		}
+	}
+}
+
+// hasAttrs is the method that returns true if any SGR attribute is active.
+func (s sgrState) hasAttrs() bool {
+	for _, attr := range s.attrs {
+		if len(attr) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// prefix is the method that returns escape sequences to restore the active
+// attributes at the head of a line.
+func (s sgrState) prefix() string {
+	var params []string
+	for _, attr := range s.attrs {
+		if len(attr) > 0 {
+			params = append(params, attr)
+		}
+	}
+	if len(params) == 0 {
+		return s.link
+	}
+	return "\x1b[" + strings.Join(params, ";") + "m" + s.link
+}
+
+// suffix is the method that returns escape sequences to reset the active
+// attributes at the end of a line.
+func (s sgrState) suffix() string {
+	var sfx string
+	if len(s.link) > 0 {
+		sfx = linkClose
+	}
+	if s.hasAttrs() {
+		sfx += sgrReset
+	}
+	return sfx
+}
+
+func sgrParams(seq string) (string, bool) {
+	var body string
+	if strings.HasPrefix(seq, "\x1b[") {
+		body = seq[2:]
+	} else if strings.HasPrefix(seq, "\u009b") {
+		body = seq[len("\u009b"):]
+	} else {
+		return "", false
+	}
+	if !strings.HasSuffix(body, "m") {
+		return "", false
+	}
+	return body[0 : len(body)-1], true
+}
+
+func hyperlinkURI(seq string) (string, bool) {
+	var body string
+	if strings.HasPrefix(seq, "\x1b]8;") {
+		body = seq[4:]
+	} else if strings.HasPrefix(seq, "\u009d8;") {
+		body = seq[len("\u009d8;"):]
+	} else {
+		return "", false
+	}
+	body = strings.TrimSuffix(body, "\x1b\\")
+	body = strings.TrimSuffix(body, "\a")
+	body = strings.TrimSuffix(body, "\u009c")
+
+	i := strings.IndexByte(body, ';')
+	if i < 0 {
+		return "", false
+	}
+	return body[i+1:], true
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"io"
+	"strings"
+)
+
+// Config is the struct that holds a line width and options, and creates
+// LineIter and Writer instances with them.
+// A Config is immutable once created, so that it can be reused and shared by
+// multiple goroutines. Each LineIter or Writer created by a Config has its own
+// state and must not be used by multiple goroutines concurrently.
+type Config struct {
+	lineWidth int
+	opts      []Option
+}
+
+// NewConfig is the function that creates a Config instance with the
+// specified line width and options.
+// The options should not modify any value shared by the created LineIter
+// instances, as the options provided by this package do.
+func NewConfig(lineWidth int, opts ...Option) Config {
+	return Config{
+		lineWidth: lineWidth,
+		opts:      append([]Option(nil), opts...),
+	}
+}
+
+// LineWidth is the method that returns the line width of this Config.
+func (cfg Config) LineWidth() int {
+	return cfg.lineWidth
+}
+
+// With is the method that creates a new Config instance which has the options
+// of this Config followed by the specified options.
+// This Config is not changed.
+func (cfg Config) With(opts ...Option) Config {
+	all := make([]Option, 0, len(cfg.opts)+len(opts))
+	all = append(all, cfg.opts...)
+	all = append(all, opts...)
+	return Config{lineWidth: cfg.lineWidth, opts: all}
+}
+
+// WithLineWidth is the method that creates a new Config instance which has
+// the specified line width and the options of this Config.
+// This Config is not changed.
+func (cfg Config) WithLineWidth(lineWidth int) Config {
+	return Config{lineWidth: lineWidth, opts: cfg.opts}
+}
+
+// New is the method that creates a LineIter instance which outputs the given
+// string line by line with the line width and options of this Config.
+func (cfg Config) New(text string) LineIter {
+	return cfg.NewReader(strings.NewReader(text))
+}
+
+// NewReader is the method that creates a LineIter instance which outputs the
+// text read from the given io.Reader line by line with the line width and
+// options of this Config.
+func (cfg Config) NewReader(r io.Reader) LineIter {
+	return NewReader(r, cfg.lineWidth, cfg.opts...)
+}
+
+// NewWriter is the method that creates a Writer instance which writes the
+// wrapped lines to the given io.Writer with the line width and options of
+// this Config.
+func (cfg Config) NewWriter(w io.Writer) *Writer {
+	return NewWriter(w, cfg.lineWidth, cfg.opts...)
+}
+
+// WrapLines is the method that breaks the given text into lines with the line
+// width and options of this Config, and returns the lines.
+func (cfg Config) WrapLines(text string) []string {
+	iter := cfg.New(text)
+	return wrapLines(&iter)
+}
+
+// Wrap is the method that breaks the given text into lines with the line
+// width and options of this Config, and returns the lines joined with "\n".
+func (cfg Config) Wrap(text string) string {
+	return strings.Join(cfg.WrapLines(text), "\n")
+}
+
+// Fill is the method that refills the paragraphs of the given text with the
+// line width and options of this Config, and returns the lines joined with
+// "\n". See Fill.
+func (cfg Config) Fill(text string) string {
+	return cfg.Wrap(unwrapParagraphs(text))
+}
@@ -0,0 +1,100 @@
+package linebreak_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sttk/linebreak"
+)
+
+func TestConfig_New(t *testing.T) {
+	cfg := linebreak.NewConfig(16, linebreak.WithIndent("> "))
+	assert.Equal(t, cfg.LineWidth(), 16)
+
+	iter := cfg.New("The quick brown fox jumps over the lazy dog.")
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"> The quick",
+		"> brown fox",
+		"> jumps over the",
+		"> lazy dog.",
+	})
+
+	iter = cfg.New("abc")
+	line, _ := iter.Next()
+	assert.Equal(t, line, "> abc")
+}
+
+func TestConfig_With(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog."
+	cfg := linebreak.NewConfig(16, linebreak.WithIndent("> "))
+	cfg2 := cfg.With(linebreak.WithAlignment(linebreak.AlignRight))
+	cfg3 := cfg.WithLineWidth(24)
+
+	assert.Equal(t, cfg.WrapLines(text), []string{
+		"> The quick",
+		"> brown fox",
+		"> jumps over the",
+		"> lazy dog.",
+	})
+	assert.Equal(t, cfg2.WrapLines(text), []string{
+		">      The quick",
+		">      brown fox",
+		"> jumps over the",
+		">      lazy dog.",
+	})
+	assert.Equal(t, cfg3.WrapLines(text), []string{
+		"> The quick brown fox",
+		"> jumps over the lazy",
+		"> dog.",
+	})
+}
+
+func TestConfig_NewWriter(t *testing.T) {
+	cfg := linebreak.NewConfig(10, linebreak.WithIndent("  "))
+
+	var buf bytes.Buffer
+	w := cfg.NewWriter(&buf)
+	_, err := w.Write([]byte("abc def ghi"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.Equal(t, buf.String(), "  abc def\n  ghi\n")
+}
+
+func TestConfig_Fill(t *testing.T) {
+	cfg := linebreak.NewConfig(10)
+	assert.Equal(t, cfg.Fill("abc\ndef ghi\n\njkl"), "abc def\nghi\n\njkl")
+	assert.Equal(t, cfg.Wrap("abc\ndef ghi\n\njkl"), "abc\ndef ghi\n\njkl")
+}
+
+func TestConfig_concurrentUse(t *testing.T) {
+	cfg := linebreak.NewConfig(16,
+		linebreak.WithContinuationIndent("  "),
+		linebreak.WithHyphenator(linebreak.EnglishHyphenator()),
+	)
+	text := "The information about the quick brown fox jumps over the dog."
+	expected := cfg.WrapLines(text)
+
+	var wg sync.WaitGroup
+	results := make([][]string, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				results[i] = cfg.WrapLines(text)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, lines := range results {
+		assert.Equal(t, lines, expected)
+	}
+}
@@ -59,3 +59,29 @@ func ExampleLineIter_SetIndent() {
 	//
 	//             (Quoted from 'Effective Go')
 }
+
+func ExampleLineIter_SetContinuationIndent() {
+	option := "  -v, --verbose   "
+	text := "Prints the detail of each step of the processing, including " +
+		"the files which are read and written.\nThis option can be repeated."
+
+	fmt.Println("....:....1....:....2....:....3....:....4....:....5")
+
+	iter := linebreak.New(text, 50)
+	iter.SetFirstIndent(option)
+	iter.SetContinuationIndent(strings.Repeat(" ", linebreak.TextWidth(option)))
+	iter.SetParagraphIndent(strings.Repeat(" ", linebreak.TextWidth(option)+2))
+
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		fmt.Println(line)
+	}
+
+	// Output:
+	// ....:....1....:....2....:....3....:....4....:....5
+	//   -v, --verbose   Prints the detail of each step
+	//                   of the processing, including the
+	//                   files which are read and
+	//                   written.
+	//                     This option can be repeated.
+}
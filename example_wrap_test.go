@@ -0,0 +1,44 @@
+package linebreak_test
+
+import (
+	"fmt"
+
+	"github.com/sttk/linebreak"
+)
+
+func ExampleWrap() {
+	text := "Go is a new language. Although it borrows ideas from existing " +
+		"languages, it has unusual properties that make effective Go programs " +
+		"different in character from programs written in its relatives."
+
+	fmt.Println("....:....1....:....2....:....3....:....4....:....5")
+	fmt.Println(linebreak.Wrap(text, 50, linebreak.WithIndent("  ")))
+
+	// Output:
+	// ....:....1....:....2....:....3....:....4....:....5
+	//   Go is a new language. Although it borrows ideas
+	//   from existing languages, it has unusual
+	//   properties that make effective Go programs
+	//   different in character from programs written in
+	//   its relatives.
+}
+
+func ExampleFill() {
+	text := "Go is a new language.\n" +
+		"Although it borrows ideas from existing languages,\n" +
+		"it has unusual properties.\n" +
+		"\n" +
+		"(Quoted from 'Effective Go')"
+
+	fmt.Println("....:....1....:....2....:....3")
+	fmt.Println(linebreak.Fill(text, 30))
+
+	// Output:
+	// ....:....1....:....2....:....3
+	// Go is a new language. Although
+	// it borrows ideas from existing
+	// languages, it has unusual
+	// properties.
+	//
+	// (Quoted from 'Effective Go')
+}
@@ -0,0 +1,2034 @@
+// Code generated by internal/gen/lbtable from GraphemeBreakProperty-17.0.0.txt, emoji-data-17.0.0.txt and DerivedCoreProperties-17.0.0.txt. DO NOT EDIT.
+
+package linebreak
+
+// gcbTable is the table of the grapheme cluster break properties of UAX29,
+// which is sorted by code points. The runes not in this table are of the
+// property Other.
+var gcbTable = []gcbRange{
+	{0x0000, 0x0009, gcb_Control},
+	{0x000A, 0x000A, gcb_LF},
+	{0x000B, 0x000C, gcb_Control},
+	{0x000D, 0x000D, gcb_CR},
+	{0x000E, 0x001F, gcb_Control},
+	{0x007F, 0x009F, gcb_Control},
+	{0x00AD, 0x00AD, gcb_Control},
+	{0x0300, 0x036F, gcb_Extend},
+	{0x0483, 0x0489, gcb_Extend},
+	{0x0591, 0x05BD, gcb_Extend},
+	{0x05BF, 0x05BF, gcb_Extend},
+	{0x05C1, 0x05C2, gcb_Extend},
+	{0x05C4, 0x05C5, gcb_Extend},
+	{0x05C7, 0x05C7, gcb_Extend},
+	{0x0600, 0x0605, gcb_Prepend},
+	{0x0610, 0x061A, gcb_Extend},
+	{0x061C, 0x061C, gcb_Control},
+	{0x064B, 0x065F, gcb_Extend},
+	{0x0670, 0x0670, gcb_Extend},
+	{0x06D6, 0x06DC, gcb_Extend},
+	{0x06DD, 0x06DD, gcb_Prepend},
+	{0x06DF, 0x06E4, gcb_Extend},
+	{0x06E7, 0x06E8, gcb_Extend},
+	{0x06EA, 0x06ED, gcb_Extend},
+	{0x070F, 0x070F, gcb_Prepend},
+	{0x0711, 0x0711, gcb_Extend},
+	{0x0730, 0x074A, gcb_Extend},
+	{0x07A6, 0x07B0, gcb_Extend},
+	{0x07EB, 0x07F3, gcb_Extend},
+	{0x07FD, 0x07FD, gcb_Extend},
+	{0x0816, 0x0819, gcb_Extend},
+	{0x081B, 0x0823, gcb_Extend},
+	{0x0825, 0x0827, gcb_Extend},
+	{0x0829, 0x082D, gcb_Extend},
+	{0x0859, 0x085B, gcb_Extend},
+	{0x0890, 0x0891, gcb_Prepend},
+	{0x0897, 0x089F, gcb_Extend},
+	{0x08CA, 0x08E1, gcb_Extend},
+	{0x08E2, 0x08E2, gcb_Prepend},
+	{0x08E3, 0x0902, gcb_Extend},
+	{0x0903, 0x0903, gcb_SpacingMark},
+	{0x093A, 0x093A, gcb_Extend},
+	{0x093B, 0x093B, gcb_SpacingMark},
+	{0x093C, 0x093C, gcb_Extend},
+	{0x093E, 0x0940, gcb_SpacingMark},
+	{0x0941, 0x0948, gcb_Extend},
+	{0x0949, 0x094C, gcb_SpacingMark},
+	{0x094D, 0x094D, gcb_Extend},
+	{0x094E, 0x094F, gcb_SpacingMark},
+	{0x0951, 0x0957, gcb_Extend},
+	{0x0962, 0x0963, gcb_Extend},
+	{0x0981, 0x0981, gcb_Extend},
+	{0x0982, 0x0983, gcb_SpacingMark},
+	{0x09BC, 0x09BC, gcb_Extend},
+	{0x09BE, 0x09BE, gcb_Extend},
+	{0x09BF, 0x09C0, gcb_SpacingMark},
+	{0x09C1, 0x09C4, gcb_Extend},
+	{0x09C7, 0x09C8, gcb_SpacingMark},
+	{0x09CB, 0x09CC, gcb_SpacingMark},
+	{0x09CD, 0x09CD, gcb_Extend},
+	{0x09D7, 0x09D7, gcb_Extend},
+	{0x09E2, 0x09E3, gcb_Extend},
+	{0x09FE, 0x09FE, gcb_Extend},
+	{0x0A01, 0x0A02, gcb_Extend},
+	{0x0A03, 0x0A03, gcb_SpacingMark},
+	{0x0A3C, 0x0A3C, gcb_Extend},
+	{0x0A3E, 0x0A40, gcb_SpacingMark},
+	{0x0A41, 0x0A42, gcb_Extend},
+	{0x0A47, 0x0A48, gcb_Extend},
+	{0x0A4B, 0x0A4D, gcb_Extend},
+	{0x0A51, 0x0A51, gcb_Extend},
+	{0x0A70, 0x0A71, gcb_Extend},
+	{0x0A75, 0x0A75, gcb_Extend},
+	{0x0A81, 0x0A82, gcb_Extend},
+	{0x0A83, 0x0A83, gcb_SpacingMark},
+	{0x0ABC, 0x0ABC, gcb_Extend},
+	{0x0ABE, 0x0AC0, gcb_SpacingMark},
+	{0x0AC1, 0x0AC5, gcb_Extend},
+	{0x0AC7, 0x0AC8, gcb_Extend},
+	{0x0AC9, 0x0AC9, gcb_SpacingMark},
+	{0x0ACB, 0x0ACC, gcb_SpacingMark},
+	{0x0ACD, 0x0ACD, gcb_Extend},
+	{0x0AE2, 0x0AE3, gcb_Extend},
+	{0x0AFA, 0x0AFF, gcb_Extend},
+	{0x0B01, 0x0B01, gcb_Extend},
+	{0x0B02, 0x0B03, gcb_SpacingMark},
+	{0x0B3C, 0x0B3C, gcb_Extend},
+	{0x0B3E, 0x0B3F, gcb_Extend},
+	{0x0B40, 0x0B40, gcb_SpacingMark},
+	{0x0B41, 0x0B44, gcb_Extend},
+	{0x0B47, 0x0B48, gcb_SpacingMark},
+	{0x0B4B, 0x0B4C, gcb_SpacingMark},
+	{0x0B4D, 0x0B4D, gcb_Extend},
+	{0x0B55, 0x0B57, gcb_Extend},
+	{0x0B62, 0x0B63, gcb_Extend},
+	{0x0B82, 0x0B82, gcb_Extend},
+	{0x0BBE, 0x0BBE, gcb_Extend},
+	{0x0BBF, 0x0BBF, gcb_SpacingMark},
+	{0x0BC0, 0x0BC0, gcb_Extend},
+	{0x0BC1, 0x0BC2, gcb_SpacingMark},
+	{0x0BC6, 0x0BC8, gcb_SpacingMark},
+	{0x0BCA, 0x0BCC, gcb_SpacingMark},
+	{0x0BCD, 0x0BCD, gcb_Extend},
+	{0x0BD7, 0x0BD7, gcb_Extend},
+	{0x0C00, 0x0C00, gcb_Extend},
+	{0x0C01, 0x0C03, gcb_SpacingMark},
+	{0x0C04, 0x0C04, gcb_Extend},
+	{0x0C3C, 0x0C3C, gcb_Extend},
+	{0x0C3E, 0x0C40, gcb_Extend},
+	{0x0C41, 0x0C44, gcb_SpacingMark},
+	{0x0C46, 0x0C48, gcb_Extend},
+	{0x0C4A, 0x0C4D, gcb_Extend},
+	{0x0C55, 0x0C56, gcb_Extend},
+	{0x0C62, 0x0C63, gcb_Extend},
+	{0x0C81, 0x0C81, gcb_Extend},
+	{0x0C82, 0x0C83, gcb_SpacingMark},
+	{0x0CBC, 0x0CBC, gcb_Extend},
+	{0x0CBE, 0x0CBE, gcb_SpacingMark},
+	{0x0CBF, 0x0CC0, gcb_Extend},
+	{0x0CC1, 0x0CC1, gcb_SpacingMark},
+	{0x0CC2, 0x0CC2, gcb_Extend},
+	{0x0CC3, 0x0CC4, gcb_SpacingMark},
+	{0x0CC6, 0x0CC8, gcb_Extend},
+	{0x0CCA, 0x0CCD, gcb_Extend},
+	{0x0CD5, 0x0CD6, gcb_Extend},
+	{0x0CE2, 0x0CE3, gcb_Extend},
+	{0x0CF3, 0x0CF3, gcb_SpacingMark},
+	{0x0D00, 0x0D01, gcb_Extend},
+	{0x0D02, 0x0D03, gcb_SpacingMark},
+	{0x0D3B, 0x0D3C, gcb_Extend},
+	{0x0D3E, 0x0D3E, gcb_Extend},
+	{0x0D3F, 0x0D40, gcb_SpacingMark},
+	{0x0D41, 0x0D44, gcb_Extend},
+	{0x0D46, 0x0D48, gcb_SpacingMark},
+	{0x0D4A, 0x0D4C, gcb_SpacingMark},
+	{0x0D4D, 0x0D4D, gcb_Extend},
+	{0x0D4E, 0x0D4E, gcb_Prepend},
+	{0x0D57, 0x0D57, gcb_Extend},
+	{0x0D62, 0x0D63, gcb_Extend},
+	{0x0D81, 0x0D81, gcb_Extend},
+	{0x0D82, 0x0D83, gcb_SpacingMark},
+	{0x0DCA, 0x0DCA, gcb_Extend},
+	{0x0DCF, 0x0DCF, gcb_Extend},
+	{0x0DD0, 0x0DD1, gcb_SpacingMark},
+	{0x0DD2, 0x0DD4, gcb_Extend},
+	{0x0DD6, 0x0DD6, gcb_Extend},
+	{0x0DD8, 0x0DDE, gcb_SpacingMark},
+	{0x0DDF, 0x0DDF, gcb_Extend},
+	{0x0DF2, 0x0DF3, gcb_SpacingMark},
+	{0x0E31, 0x0E31, gcb_Extend},
+	{0x0E33, 0x0E33, gcb_SpacingMark},
+	{0x0E34, 0x0E3A, gcb_Extend},
+	{0x0E47, 0x0E4E, gcb_Extend},
+	{0x0EB1, 0x0EB1, gcb_Extend},
+	{0x0EB3, 0x0EB3, gcb_SpacingMark},
+	{0x0EB4, 0x0EBC, gcb_Extend},
+	{0x0EC8, 0x0ECE, gcb_Extend},
+	{0x0F18, 0x0F19, gcb_Extend},
+	{0x0F35, 0x0F35, gcb_Extend},
+	{0x0F37, 0x0F37, gcb_Extend},
+	{0x0F39, 0x0F39, gcb_Extend},
+	{0x0F3E, 0x0F3F, gcb_SpacingMark},
+	{0x0F71, 0x0F7E, gcb_Extend},
+	{0x0F7F, 0x0F7F, gcb_SpacingMark},
+	{0x0F80, 0x0F84, gcb_Extend},
+	{0x0F86, 0x0F87, gcb_Extend},
+	{0x0F8D, 0x0F97, gcb_Extend},
+	{0x0F99, 0x0FBC, gcb_Extend},
+	{0x0FC6, 0x0FC6, gcb_Extend},
+	{0x102D, 0x1030, gcb_Extend},
+	{0x1031, 0x1031, gcb_SpacingMark},
+	{0x1032, 0x1037, gcb_Extend},
+	{0x1039, 0x103A, gcb_Extend},
+	{0x103B, 0x103C, gcb_SpacingMark},
+	{0x103D, 0x103E, gcb_Extend},
+	{0x1056, 0x1057, gcb_SpacingMark},
+	{0x1058, 0x1059, gcb_Extend},
+	{0x105E, 0x1060, gcb_Extend},
+	{0x1071, 0x1074, gcb_Extend},
+	{0x1082, 0x1082, gcb_Extend},
+	{0x1084, 0x1084, gcb_SpacingMark},
+	{0x1085, 0x1086, gcb_Extend},
+	{0x108D, 0x108D, gcb_Extend},
+	{0x109D, 0x109D, gcb_Extend},
+	{0x1100, 0x115F, gcb_L},
+	{0x1160, 0x11A7, gcb_V},
+	{0x11A8, 0x11FF, gcb_T},
+	{0x135D, 0x135F, gcb_Extend},
+	{0x1712, 0x1715, gcb_Extend},
+	{0x1732, 0x1734, gcb_Extend},
+	{0x1752, 0x1753, gcb_Extend},
+	{0x1772, 0x1773, gcb_Extend},
+	{0x17B4, 0x17B5, gcb_Extend},
+	{0x17B6, 0x17B6, gcb_SpacingMark},
+	{0x17B7, 0x17BD, gcb_Extend},
+	{0x17BE, 0x17C5, gcb_SpacingMark},
+	{0x17C6, 0x17C6, gcb_Extend},
+	{0x17C7, 0x17C8, gcb_SpacingMark},
+	{0x17C9, 0x17D3, gcb_Extend},
+	{0x17DD, 0x17DD, gcb_Extend},
+	{0x180B, 0x180D, gcb_Extend},
+	{0x180E, 0x180E, gcb_Control},
+	{0x180F, 0x180F, gcb_Extend},
+	{0x1885, 0x1886, gcb_Extend},
+	{0x18A9, 0x18A9, gcb_Extend},
+	{0x1920, 0x1922, gcb_Extend},
+	{0x1923, 0x1926, gcb_SpacingMark},
+	{0x1927, 0x1928, gcb_Extend},
+	{0x1929, 0x192B, gcb_SpacingMark},
+	{0x1930, 0x1931, gcb_SpacingMark},
+	{0x1932, 0x1932, gcb_Extend},
+	{0x1933, 0x1938, gcb_SpacingMark},
+	{0x1939, 0x193B, gcb_Extend},
+	{0x1A17, 0x1A18, gcb_Extend},
+	{0x1A19, 0x1A1A, gcb_SpacingMark},
+	{0x1A1B, 0x1A1B, gcb_Extend},
+	{0x1A55, 0x1A55, gcb_SpacingMark},
+	{0x1A56, 0x1A56, gcb_Extend},
+	{0x1A57, 0x1A57, gcb_SpacingMark},
+	{0x1A58, 0x1A5E, gcb_Extend},
+	{0x1A60, 0x1A60, gcb_Extend},
+	{0x1A62, 0x1A62, gcb_Extend},
+	{0x1A65, 0x1A6C, gcb_Extend},
+	{0x1A6D, 0x1A72, gcb_SpacingMark},
+	{0x1A73, 0x1A7C, gcb_Extend},
+	{0x1A7F, 0x1A7F, gcb_Extend},
+	{0x1AB0, 0x1ADD, gcb_Extend},
+	{0x1AE0, 0x1AEB, gcb_Extend},
+	{0x1B00, 0x1B03, gcb_Extend},
+	{0x1B04, 0x1B04, gcb_SpacingMark},
+	{0x1B34, 0x1B3D, gcb_Extend},
+	{0x1B3E, 0x1B41, gcb_SpacingMark},
+	{0x1B42, 0x1B44, gcb_Extend},
+	{0x1B6B, 0x1B73, gcb_Extend},
+	{0x1B80, 0x1B81, gcb_Extend},
+	{0x1B82, 0x1B82, gcb_SpacingMark},
+	{0x1BA1, 0x1BA1, gcb_SpacingMark},
+	{0x1BA2, 0x1BA5, gcb_Extend},
+	{0x1BA6, 0x1BA7, gcb_SpacingMark},
+	{0x1BA8, 0x1BAD, gcb_Extend},
+	{0x1BE6, 0x1BE6, gcb_Extend},
+	{0x1BE7, 0x1BE7, gcb_SpacingMark},
+	{0x1BE8, 0x1BE9, gcb_Extend},
+	{0x1BEA, 0x1BEC, gcb_SpacingMark},
+	{0x1BED, 0x1BED, gcb_Extend},
+	{0x1BEE, 0x1BEE, gcb_SpacingMark},
+	{0x1BEF, 0x1BF3, gcb_Extend},
+	{0x1C24, 0x1C2B, gcb_SpacingMark},
+	{0x1C2C, 0x1C33, gcb_Extend},
+	{0x1C34, 0x1C35, gcb_SpacingMark},
+	{0x1C36, 0x1C37, gcb_Extend},
+	{0x1CD0, 0x1CD2, gcb_Extend},
+	{0x1CD4, 0x1CE0, gcb_Extend},
+	{0x1CE1, 0x1CE1, gcb_SpacingMark},
+	{0x1CE2, 0x1CE8, gcb_Extend},
+	{0x1CED, 0x1CED, gcb_Extend},
+	{0x1CF4, 0x1CF4, gcb_Extend},
+	{0x1CF7, 0x1CF7, gcb_SpacingMark},
+	{0x1CF8, 0x1CF9, gcb_Extend},
+	{0x1DC0, 0x1DFF, gcb_Extend},
+	{0x200B, 0x200B, gcb_Control},
+	{0x200C, 0x200C, gcb_Extend},
+	{0x200D, 0x200D, gcb_ZWJ},
+	{0x200E, 0x200F, gcb_Control},
+	{0x2028, 0x202E, gcb_Control},
+	{0x2060, 0x206F, gcb_Control},
+	{0x20D0, 0x20F0, gcb_Extend},
+	{0x2CEF, 0x2CF1, gcb_Extend},
+	{0x2D7F, 0x2D7F, gcb_Extend},
+	{0x2DE0, 0x2DFF, gcb_Extend},
+	{0x302A, 0x302F, gcb_Extend},
+	{0x3099, 0x309A, gcb_Extend},
+	{0xA66F, 0xA672, gcb_Extend},
+	{0xA674, 0xA67D, gcb_Extend},
+	{0xA69E, 0xA69F, gcb_Extend},
+	{0xA6F0, 0xA6F1, gcb_Extend},
+	{0xA802, 0xA802, gcb_Extend},
+	{0xA806, 0xA806, gcb_Extend},
+	{0xA80B, 0xA80B, gcb_Extend},
+	{0xA823, 0xA824, gcb_SpacingMark},
+	{0xA825, 0xA826, gcb_Extend},
+	{0xA827, 0xA827, gcb_SpacingMark},
+	{0xA82C, 0xA82C, gcb_Extend},
+	{0xA880, 0xA881, gcb_SpacingMark},
+	{0xA8B4, 0xA8C3, gcb_SpacingMark},
+	{0xA8C4, 0xA8C5, gcb_Extend},
+	{0xA8E0, 0xA8F1, gcb_Extend},
+	{0xA8FF, 0xA8FF, gcb_Extend},
+	{0xA926, 0xA92D, gcb_Extend},
+	{0xA947, 0xA951, gcb_Extend},
+	{0xA952, 0xA952, gcb_SpacingMark},
+	{0xA953, 0xA953, gcb_Extend},
+	{0xA960, 0xA97C, gcb_L},
+	{0xA980, 0xA982, gcb_Extend},
+	{0xA983, 0xA983, gcb_SpacingMark},
+	{0xA9B3, 0xA9B3, gcb_Extend},
+	{0xA9B4, 0xA9B5, gcb_SpacingMark},
+	{0xA9B6, 0xA9B9, gcb_Extend},
+	{0xA9BA, 0xA9BB, gcb_SpacingMark},
+	{0xA9BC, 0xA9BD, gcb_Extend},
+	{0xA9BE, 0xA9BF, gcb_SpacingMark},
+	{0xA9C0, 0xA9C0, gcb_Extend},
+	{0xA9E5, 0xA9E5, gcb_Extend},
+	{0xAA29, 0xAA2E, gcb_Extend},
+	{0xAA2F, 0xAA30, gcb_SpacingMark},
+	{0xAA31, 0xAA32, gcb_Extend},
+	{0xAA33, 0xAA34, gcb_SpacingMark},
+	{0xAA35, 0xAA36, gcb_Extend},
+	{0xAA43, 0xAA43, gcb_Extend},
+	{0xAA4C, 0xAA4C, gcb_Extend},
+	{0xAA4D, 0xAA4D, gcb_SpacingMark},
+	{0xAA7C, 0xAA7C, gcb_Extend},
+	{0xAAB0, 0xAAB0, gcb_Extend},
+	{0xAAB2, 0xAAB4, gcb_Extend},
+	{0xAAB7, 0xAAB8, gcb_Extend},
+	{0xAABE, 0xAABF, gcb_Extend},
+	{0xAAC1, 0xAAC1, gcb_Extend},
+	{0xAAEB, 0xAAEB, gcb_SpacingMark},
+	{0xAAEC, 0xAAED, gcb_Extend},
+	{0xAAEE, 0xAAEF, gcb_SpacingMark},
+	{0xAAF5, 0xAAF5, gcb_SpacingMark},
+	{0xAAF6, 0xAAF6, gcb_Extend},
+	{0xABE3, 0xABE4, gcb_SpacingMark},
+	{0xABE5, 0xABE5, gcb_Extend},
+	{0xABE6, 0xABE7, gcb_SpacingMark},
+	{0xABE8, 0xABE8, gcb_Extend},
+	{0xABE9, 0xABEA, gcb_SpacingMark},
+	{0xABEC, 0xABEC, gcb_SpacingMark},
+	{0xABED, 0xABED, gcb_Extend},
+	{0xAC00, 0xAC00, gcb_LV},
+	{0xAC01, 0xAC1B, gcb_LVT},
+	{0xAC1C, 0xAC1C, gcb_LV},
+	{0xAC1D, 0xAC37, gcb_LVT},
+	{0xAC38, 0xAC38, gcb_LV},
+	{0xAC39, 0xAC53, gcb_LVT},
+	{0xAC54, 0xAC54, gcb_LV},
+	{0xAC55, 0xAC6F, gcb_LVT},
+	{0xAC70, 0xAC70, gcb_LV},
+	{0xAC71, 0xAC8B, gcb_LVT},
+	{0xAC8C, 0xAC8C, gcb_LV},
+	{0xAC8D, 0xACA7, gcb_LVT},
+	{0xACA8, 0xACA8, gcb_LV},
+	{0xACA9, 0xACC3, gcb_LVT},
+	{0xACC4, 0xACC4, gcb_LV},
+	{0xACC5, 0xACDF, gcb_LVT},
+	{0xACE0, 0xACE0, gcb_LV},
+	{0xACE1, 0xACFB, gcb_LVT},
+	{0xACFC, 0xACFC, gcb_LV},
+	{0xACFD, 0xAD17, gcb_LVT},
+	{0xAD18, 0xAD18, gcb_LV},
+	{0xAD19, 0xAD33, gcb_LVT},
+	{0xAD34, 0xAD34, gcb_LV},
+	{0xAD35, 0xAD4F, gcb_LVT},
+	{0xAD50, 0xAD50, gcb_LV},
+	{0xAD51, 0xAD6B, gcb_LVT},
+	{0xAD6C, 0xAD6C, gcb_LV},
+	{0xAD6D, 0xAD87, gcb_LVT},
+	{0xAD88, 0xAD88, gcb_LV},
+	{0xAD89, 0xADA3, gcb_LVT},
+	{0xADA4, 0xADA4, gcb_LV},
+	{0xADA5, 0xADBF, gcb_LVT},
+	{0xADC0, 0xADC0, gcb_LV},
+	{0xADC1, 0xADDB, gcb_LVT},
+	{0xADDC, 0xADDC, gcb_LV},
+	{0xADDD, 0xADF7, gcb_LVT},
+	{0xADF8, 0xADF8, gcb_LV},
+	{0xADF9, 0xAE13, gcb_LVT},
+	{0xAE14, 0xAE14, gcb_LV},
+	{0xAE15, 0xAE2F, gcb_LVT},
+	{0xAE30, 0xAE30, gcb_LV},
+	{0xAE31, 0xAE4B, gcb_LVT},
+	{0xAE4C, 0xAE4C, gcb_LV},
+	{0xAE4D, 0xAE67, gcb_LVT},
+	{0xAE68, 0xAE68, gcb_LV},
+	{0xAE69, 0xAE83, gcb_LVT},
+	{0xAE84, 0xAE84, gcb_LV},
+	{0xAE85, 0xAE9F, gcb_LVT},
+	{0xAEA0, 0xAEA0, gcb_LV},
+	{0xAEA1, 0xAEBB, gcb_LVT},
+	{0xAEBC, 0xAEBC, gcb_LV},
+	{0xAEBD, 0xAED7, gcb_LVT},
+	{0xAED8, 0xAED8, gcb_LV},
+	{0xAED9, 0xAEF3, gcb_LVT},
+	{0xAEF4, 0xAEF4, gcb_LV},
+	{0xAEF5, 0xAF0F, gcb_LVT},
+	{0xAF10, 0xAF10, gcb_LV},
+	{0xAF11, 0xAF2B, gcb_LVT},
+	{0xAF2C, 0xAF2C, gcb_LV},
+	{0xAF2D, 0xAF47, gcb_LVT},
+	{0xAF48, 0xAF48, gcb_LV},
+	{0xAF49, 0xAF63, gcb_LVT},
+	{0xAF64, 0xAF64, gcb_LV},
+	{0xAF65, 0xAF7F, gcb_LVT},
+	{0xAF80, 0xAF80, gcb_LV},
+	{0xAF81, 0xAF9B, gcb_LVT},
+	{0xAF9C, 0xAF9C, gcb_LV},
+	{0xAF9D, 0xAFB7, gcb_LVT},
+	{0xAFB8, 0xAFB8, gcb_LV},
+	{0xAFB9, 0xAFD3, gcb_LVT},
+	{0xAFD4, 0xAFD4, gcb_LV},
+	{0xAFD5, 0xAFEF, gcb_LVT},
+	{0xAFF0, 0xAFF0, gcb_LV},
+	{0xAFF1, 0xB00B, gcb_LVT},
+	{0xB00C, 0xB00C, gcb_LV},
+	{0xB00D, 0xB027, gcb_LVT},
+	{0xB028, 0xB028, gcb_LV},
+	{0xB029, 0xB043, gcb_LVT},
+	{0xB044, 0xB044, gcb_LV},
+	{0xB045, 0xB05F, gcb_LVT},
+	{0xB060, 0xB060, gcb_LV},
+	{0xB061, 0xB07B, gcb_LVT},
+	{0xB07C, 0xB07C, gcb_LV},
+	{0xB07D, 0xB097, gcb_LVT},
+	{0xB098, 0xB098, gcb_LV},
+	{0xB099, 0xB0B3, gcb_LVT},
+	{0xB0B4, 0xB0B4, gcb_LV},
+	{0xB0B5, 0xB0CF, gcb_LVT},
+	{0xB0D0, 0xB0D0, gcb_LV},
+	{0xB0D1, 0xB0EB, gcb_LVT},
+	{0xB0EC, 0xB0EC, gcb_LV},
+	{0xB0ED, 0xB107, gcb_LVT},
+	{0xB108, 0xB108, gcb_LV},
+	{0xB109, 0xB123, gcb_LVT},
+	{0xB124, 0xB124, gcb_LV},
+	{0xB125, 0xB13F, gcb_LVT},
+	{0xB140, 0xB140, gcb_LV},
+	{0xB141, 0xB15B, gcb_LVT},
+	{0xB15C, 0xB15C, gcb_LV},
+	{0xB15D, 0xB177, gcb_LVT},
+	{0xB178, 0xB178, gcb_LV},
+	{0xB179, 0xB193, gcb_LVT},
+	{0xB194, 0xB194, gcb_LV},
+	{0xB195, 0xB1AF, gcb_LVT},
+	{0xB1B0, 0xB1B0, gcb_LV},
+	{0xB1B1, 0xB1CB, gcb_LVT},
+	{0xB1CC, 0xB1CC, gcb_LV},
+	{0xB1CD, 0xB1E7, gcb_LVT},
+	{0xB1E8, 0xB1E8, gcb_LV},
+	{0xB1E9, 0xB203, gcb_LVT},
+	{0xB204, 0xB204, gcb_LV},
+	{0xB205, 0xB21F, gcb_LVT},
+	{0xB220, 0xB220, gcb_LV},
+	{0xB221, 0xB23B, gcb_LVT},
+	{0xB23C, 0xB23C, gcb_LV},
+	{0xB23D, 0xB257, gcb_LVT},
+	{0xB258, 0xB258, gcb_LV},
+	{0xB259, 0xB273, gcb_LVT},
+	{0xB274, 0xB274, gcb_LV},
+	{0xB275, 0xB28F, gcb_LVT},
+	{0xB290, 0xB290, gcb_LV},
+	{0xB291, 0xB2AB, gcb_LVT},
+	{0xB2AC, 0xB2AC, gcb_LV},
+	{0xB2AD, 0xB2C7, gcb_LVT},
+	{0xB2C8, 0xB2C8, gcb_LV},
+	{0xB2C9, 0xB2E3, gcb_LVT},
+	{0xB2E4, 0xB2E4, gcb_LV},
+	{0xB2E5, 0xB2FF, gcb_LVT},
+	{0xB300, 0xB300, gcb_LV},
+	{0xB301, 0xB31B, gcb_LVT},
+	{0xB31C, 0xB31C, gcb_LV},
+	{0xB31D, 0xB337, gcb_LVT},
+	{0xB338, 0xB338, gcb_LV},
+	{0xB339, 0xB353, gcb_LVT},
+	{0xB354, 0xB354, gcb_LV},
+	{0xB355, 0xB36F, gcb_LVT},
+	{0xB370, 0xB370, gcb_LV},
+	{0xB371, 0xB38B, gcb_LVT},
+	{0xB38C, 0xB38C, gcb_LV},
+	{0xB38D, 0xB3A7, gcb_LVT},
+	{0xB3A8, 0xB3A8, gcb_LV},
+	{0xB3A9, 0xB3C3, gcb_LVT},
+	{0xB3C4, 0xB3C4, gcb_LV},
+	{0xB3C5, 0xB3DF, gcb_LVT},
+	{0xB3E0, 0xB3E0, gcb_LV},
+	{0xB3E1, 0xB3FB, gcb_LVT},
+	{0xB3FC, 0xB3FC, gcb_LV},
+	{0xB3FD, 0xB417, gcb_LVT},
+	{0xB418, 0xB418, gcb_LV},
+	{0xB419, 0xB433, gcb_LVT},
+	{0xB434, 0xB434, gcb_LV},
+	{0xB435, 0xB44F, gcb_LVT},
+	{0xB450, 0xB450, gcb_LV},
+	{0xB451, 0xB46B, gcb_LVT},
+	{0xB46C, 0xB46C, gcb_LV},
+	{0xB46D, 0xB487, gcb_LVT},
+	{0xB488, 0xB488, gcb_LV},
+	{0xB489, 0xB4A3, gcb_LVT},
+	{0xB4A4, 0xB4A4, gcb_LV},
+	{0xB4A5, 0xB4BF, gcb_LVT},
+	{0xB4C0, 0xB4C0, gcb_LV},
+	{0xB4C1, 0xB4DB, gcb_LVT},
+	{0xB4DC, 0xB4DC, gcb_LV},
+	{0xB4DD, 0xB4F7, gcb_LVT},
+	{0xB4F8, 0xB4F8, gcb_LV},
+	{0xB4F9, 0xB513, gcb_LVT},
+	{0xB514, 0xB514, gcb_LV},
+	{0xB515, 0xB52F, gcb_LVT},
+	{0xB530, 0xB530, gcb_LV},
+	{0xB531, 0xB54B, gcb_LVT},
+	{0xB54C, 0xB54C, gcb_LV},
+	{0xB54D, 0xB567, gcb_LVT},
+	{0xB568, 0xB568, gcb_LV},
+	{0xB569, 0xB583, gcb_LVT},
+	{0xB584, 0xB584, gcb_LV},
+	{0xB585, 0xB59F, gcb_LVT},
+	{0xB5A0, 0xB5A0, gcb_LV},
+	{0xB5A1, 0xB5BB, gcb_LVT},
+	{0xB5BC, 0xB5BC, gcb_LV},
+	{0xB5BD, 0xB5D7, gcb_LVT},
+	{0xB5D8, 0xB5D8, gcb_LV},
+	{0xB5D9, 0xB5F3, gcb_LVT},
+	{0xB5F4, 0xB5F4, gcb_LV},
+	{0xB5F5, 0xB60F, gcb_LVT},
+	{0xB610, 0xB610, gcb_LV},
+	{0xB611, 0xB62B, gcb_LVT},
+	{0xB62C, 0xB62C, gcb_LV},
+	{0xB62D, 0xB647, gcb_LVT},
+	{0xB648, 0xB648, gcb_LV},
+	{0xB649, 0xB663, gcb_LVT},
+	{0xB664, 0xB664, gcb_LV},
+	{0xB665, 0xB67F, gcb_LVT},
+	{0xB680, 0xB680, gcb_LV},
+	{0xB681, 0xB69B, gcb_LVT},
+	{0xB69C, 0xB69C, gcb_LV},
+	{0xB69D, 0xB6B7, gcb_LVT},
+	{0xB6B8, 0xB6B8, gcb_LV},
+	{0xB6B9, 0xB6D3, gcb_LVT},
+	{0xB6D4, 0xB6D4, gcb_LV},
+	{0xB6D5, 0xB6EF, gcb_LVT},
+	{0xB6F0, 0xB6F0, gcb_LV},
+	{0xB6F1, 0xB70B, gcb_LVT},
+	{0xB70C, 0xB70C, gcb_LV},
+	{0xB70D, 0xB727, gcb_LVT},
+	{0xB728, 0xB728, gcb_LV},
+	{0xB729, 0xB743, gcb_LVT},
+	{0xB744, 0xB744, gcb_LV},
+	{0xB745, 0xB75F, gcb_LVT},
+	{0xB760, 0xB760, gcb_LV},
+	{0xB761, 0xB77B, gcb_LVT},
+	{0xB77C, 0xB77C, gcb_LV},
+	{0xB77D, 0xB797, gcb_LVT},
+	{0xB798, 0xB798, gcb_LV},
+	{0xB799, 0xB7B3, gcb_LVT},
+	{0xB7B4, 0xB7B4, gcb_LV},
+	{0xB7B5, 0xB7CF, gcb_LVT},
+	{0xB7D0, 0xB7D0, gcb_LV},
+	{0xB7D1, 0xB7EB, gcb_LVT},
+	{0xB7EC, 0xB7EC, gcb_LV},
+	{0xB7ED, 0xB807, gcb_LVT},
+	{0xB808, 0xB808, gcb_LV},
+	{0xB809, 0xB823, gcb_LVT},
+	{0xB824, 0xB824, gcb_LV},
+	{0xB825, 0xB83F, gcb_LVT},
+	{0xB840, 0xB840, gcb_LV},
+	{0xB841, 0xB85B, gcb_LVT},
+	{0xB85C, 0xB85C, gcb_LV},
+	{0xB85D, 0xB877, gcb_LVT},
+	{0xB878, 0xB878, gcb_LV},
+	{0xB879, 0xB893, gcb_LVT},
+	{0xB894, 0xB894, gcb_LV},
+	{0xB895, 0xB8AF, gcb_LVT},
+	{0xB8B0, 0xB8B0, gcb_LV},
+	{0xB8B1, 0xB8CB, gcb_LVT},
+	{0xB8CC, 0xB8CC, gcb_LV},
+	{0xB8CD, 0xB8E7, gcb_LVT},
+	{0xB8E8, 0xB8E8, gcb_LV},
+	{0xB8E9, 0xB903, gcb_LVT},
+	{0xB904, 0xB904, gcb_LV},
+	{0xB905, 0xB91F, gcb_LVT},
+	{0xB920, 0xB920, gcb_LV},
+	{0xB921, 0xB93B, gcb_LVT},
+	{0xB93C, 0xB93C, gcb_LV},
+	{0xB93D, 0xB957, gcb_LVT},
+	{0xB958, 0xB958, gcb_LV},
+	{0xB959, 0xB973, gcb_LVT},
+	{0xB974, 0xB974, gcb_LV},
+	{0xB975, 0xB98F, gcb_LVT},
+	{0xB990, 0xB990, gcb_LV},
+	{0xB991, 0xB9AB, gcb_LVT},
+	{0xB9AC, 0xB9AC, gcb_LV},
+	{0xB9AD, 0xB9C7, gcb_LVT},
+	{0xB9C8, 0xB9C8, gcb_LV},
+	{0xB9C9, 0xB9E3, gcb_LVT},
+	{0xB9E4, 0xB9E4, gcb_LV},
+	{0xB9E5, 0xB9FF, gcb_LVT},
+	{0xBA00, 0xBA00, gcb_LV},
+	{0xBA01, 0xBA1B, gcb_LVT},
+	{0xBA1C, 0xBA1C, gcb_LV},
+	{0xBA1D, 0xBA37, gcb_LVT},
+	{0xBA38, 0xBA38, gcb_LV},
+	{0xBA39, 0xBA53, gcb_LVT},
+	{0xBA54, 0xBA54, gcb_LV},
+	{0xBA55, 0xBA6F, gcb_LVT},
+	{0xBA70, 0xBA70, gcb_LV},
+	{0xBA71, 0xBA8B, gcb_LVT},
+	{0xBA8C, 0xBA8C, gcb_LV},
+	{0xBA8D, 0xBAA7, gcb_LVT},
+	{0xBAA8, 0xBAA8, gcb_LV},
+	{0xBAA9, 0xBAC3, gcb_LVT},
+	{0xBAC4, 0xBAC4, gcb_LV},
+	{0xBAC5, 0xBADF, gcb_LVT},
+	{0xBAE0, 0xBAE0, gcb_LV},
+	{0xBAE1, 0xBAFB, gcb_LVT},
+	{0xBAFC, 0xBAFC, gcb_LV},
+	{0xBAFD, 0xBB17, gcb_LVT},
+	{0xBB18, 0xBB18, gcb_LV},
+	{0xBB19, 0xBB33, gcb_LVT},
+	{0xBB34, 0xBB34, gcb_LV},
+	{0xBB35, 0xBB4F, gcb_LVT},
+	{0xBB50, 0xBB50, gcb_LV},
+	{0xBB51, 0xBB6B, gcb_LVT},
+	{0xBB6C, 0xBB6C, gcb_LV},
+	{0xBB6D, 0xBB87, gcb_LVT},
+	{0xBB88, 0xBB88, gcb_LV},
+	{0xBB89, 0xBBA3, gcb_LVT},
+	{0xBBA4, 0xBBA4, gcb_LV},
+	{0xBBA5, 0xBBBF, gcb_LVT},
+	{0xBBC0, 0xBBC0, gcb_LV},
+	{0xBBC1, 0xBBDB, gcb_LVT},
+	{0xBBDC, 0xBBDC, gcb_LV},
+	{0xBBDD, 0xBBF7, gcb_LVT},
+	{0xBBF8, 0xBBF8, gcb_LV},
+	{0xBBF9, 0xBC13, gcb_LVT},
+	{0xBC14, 0xBC14, gcb_LV},
+	{0xBC15, 0xBC2F, gcb_LVT},
+	{0xBC30, 0xBC30, gcb_LV},
+	{0xBC31, 0xBC4B, gcb_LVT},
+	{0xBC4C, 0xBC4C, gcb_LV},
+	{0xBC4D, 0xBC67, gcb_LVT},
+	{0xBC68, 0xBC68, gcb_LV},
+	{0xBC69, 0xBC83, gcb_LVT},
+	{0xBC84, 0xBC84, gcb_LV},
+	{0xBC85, 0xBC9F, gcb_LVT},
+	{0xBCA0, 0xBCA0, gcb_LV},
+	{0xBCA1, 0xBCBB, gcb_LVT},
+	{0xBCBC, 0xBCBC, gcb_LV},
+	{0xBCBD, 0xBCD7, gcb_LVT},
+	{0xBCD8, 0xBCD8, gcb_LV},
+	{0xBCD9, 0xBCF3, gcb_LVT},
+	{0xBCF4, 0xBCF4, gcb_LV},
+	{0xBCF5, 0xBD0F, gcb_LVT},
+	{0xBD10, 0xBD10, gcb_LV},
+	{0xBD11, 0xBD2B, gcb_LVT},
+	{0xBD2C, 0xBD2C, gcb_LV},
+	{0xBD2D, 0xBD47, gcb_LVT},
+	{0xBD48, 0xBD48, gcb_LV},
+	{0xBD49, 0xBD63, gcb_LVT},
+	{0xBD64, 0xBD64, gcb_LV},
+	{0xBD65, 0xBD7F, gcb_LVT},
+	{0xBD80, 0xBD80, gcb_LV},
+	{0xBD81, 0xBD9B, gcb_LVT},
+	{0xBD9C, 0xBD9C, gcb_LV},
+	{0xBD9D, 0xBDB7, gcb_LVT},
+	{0xBDB8, 0xBDB8, gcb_LV},
+	{0xBDB9, 0xBDD3, gcb_LVT},
+	{0xBDD4, 0xBDD4, gcb_LV},
+	{0xBDD5, 0xBDEF, gcb_LVT},
+	{0xBDF0, 0xBDF0, gcb_LV},
+	{0xBDF1, 0xBE0B, gcb_LVT},
+	{0xBE0C, 0xBE0C, gcb_LV},
+	{0xBE0D, 0xBE27, gcb_LVT},
+	{0xBE28, 0xBE28, gcb_LV},
+	{0xBE29, 0xBE43, gcb_LVT},
+	{0xBE44, 0xBE44, gcb_LV},
+	{0xBE45, 0xBE5F, gcb_LVT},
+	{0xBE60, 0xBE60, gcb_LV},
+	{0xBE61, 0xBE7B, gcb_LVT},
+	{0xBE7C, 0xBE7C, gcb_LV},
+	{0xBE7D, 0xBE97, gcb_LVT},
+	{0xBE98, 0xBE98, gcb_LV},
+	{0xBE99, 0xBEB3, gcb_LVT},
+	{0xBEB4, 0xBEB4, gcb_LV},
+	{0xBEB5, 0xBECF, gcb_LVT},
+	{0xBED0, 0xBED0, gcb_LV},
+	{0xBED1, 0xBEEB, gcb_LVT},
+	{0xBEEC, 0xBEEC, gcb_LV},
+	{0xBEED, 0xBF07, gcb_LVT},
+	{0xBF08, 0xBF08, gcb_LV},
+	{0xBF09, 0xBF23, gcb_LVT},
+	{0xBF24, 0xBF24, gcb_LV},
+	{0xBF25, 0xBF3F, gcb_LVT},
+	{0xBF40, 0xBF40, gcb_LV},
+	{0xBF41, 0xBF5B, gcb_LVT},
+	{0xBF5C, 0xBF5C, gcb_LV},
+	{0xBF5D, 0xBF77, gcb_LVT},
+	{0xBF78, 0xBF78, gcb_LV},
+	{0xBF79, 0xBF93, gcb_LVT},
+	{0xBF94, 0xBF94, gcb_LV},
+	{0xBF95, 0xBFAF, gcb_LVT},
+	{0xBFB0, 0xBFB0, gcb_LV},
+	{0xBFB1, 0xBFCB, gcb_LVT},
+	{0xBFCC, 0xBFCC, gcb_LV},
+	{0xBFCD, 0xBFE7, gcb_LVT},
+	{0xBFE8, 0xBFE8, gcb_LV},
+	{0xBFE9, 0xC003, gcb_LVT},
+	{0xC004, 0xC004, gcb_LV},
+	{0xC005, 0xC01F, gcb_LVT},
+	{0xC020, 0xC020, gcb_LV},
+	{0xC021, 0xC03B, gcb_LVT},
+	{0xC03C, 0xC03C, gcb_LV},
+	{0xC03D, 0xC057, gcb_LVT},
+	{0xC058, 0xC058, gcb_LV},
+	{0xC059, 0xC073, gcb_LVT},
+	{0xC074, 0xC074, gcb_LV},
+	{0xC075, 0xC08F, gcb_LVT},
+	{0xC090, 0xC090, gcb_LV},
+	{0xC091, 0xC0AB, gcb_LVT},
+	{0xC0AC, 0xC0AC, gcb_LV},
+	{0xC0AD, 0xC0C7, gcb_LVT},
+	{0xC0C8, 0xC0C8, gcb_LV},
+	{0xC0C9, 0xC0E3, gcb_LVT},
+	{0xC0E4, 0xC0E4, gcb_LV},
+	{0xC0E5, 0xC0FF, gcb_LVT},
+	{0xC100, 0xC100, gcb_LV},
+	{0xC101, 0xC11B, gcb_LVT},
+	{0xC11C, 0xC11C, gcb_LV},
+	{0xC11D, 0xC137, gcb_LVT},
+	{0xC138, 0xC138, gcb_LV},
+	{0xC139, 0xC153, gcb_LVT},
+	{0xC154, 0xC154, gcb_LV},
+	{0xC155, 0xC16F, gcb_LVT},
+	{0xC170, 0xC170, gcb_LV},
+	{0xC171, 0xC18B, gcb_LVT},
+	{0xC18C, 0xC18C, gcb_LV},
+	{0xC18D, 0xC1A7, gcb_LVT},
+	{0xC1A8, 0xC1A8, gcb_LV},
+	{0xC1A9, 0xC1C3, gcb_LVT},
+	{0xC1C4, 0xC1C4, gcb_LV},
+	{0xC1C5, 0xC1DF, gcb_LVT},
+	{0xC1E0, 0xC1E0, gcb_LV},
+	{0xC1E1, 0xC1FB, gcb_LVT},
+	{0xC1FC, 0xC1FC, gcb_LV},
+	{0xC1FD, 0xC217, gcb_LVT},
+	{0xC218, 0xC218, gcb_LV},
+	{0xC219, 0xC233, gcb_LVT},
+	{0xC234, 0xC234, gcb_LV},
+	{0xC235, 0xC24F, gcb_LVT},
+	{0xC250, 0xC250, gcb_LV},
+	{0xC251, 0xC26B, gcb_LVT},
+	{0xC26C, 0xC26C, gcb_LV},
+	{0xC26D, 0xC287, gcb_LVT},
+	{0xC288, 0xC288, gcb_LV},
+	{0xC289, 0xC2A3, gcb_LVT},
+	{0xC2A4, 0xC2A4, gcb_LV},
+	{0xC2A5, 0xC2BF, gcb_LVT},
+	{0xC2C0, 0xC2C0, gcb_LV},
+	{0xC2C1, 0xC2DB, gcb_LVT},
+	{0xC2DC, 0xC2DC, gcb_LV},
+	{0xC2DD, 0xC2F7, gcb_LVT},
+	{0xC2F8, 0xC2F8, gcb_LV},
+	{0xC2F9, 0xC313, gcb_LVT},
+	{0xC314, 0xC314, gcb_LV},
+	{0xC315, 0xC32F, gcb_LVT},
+	{0xC330, 0xC330, gcb_LV},
+	{0xC331, 0xC34B, gcb_LVT},
+	{0xC34C, 0xC34C, gcb_LV},
+	{0xC34D, 0xC367, gcb_LVT},
+	{0xC368, 0xC368, gcb_LV},
+	{0xC369, 0xC383, gcb_LVT},
+	{0xC384, 0xC384, gcb_LV},
+	{0xC385, 0xC39F, gcb_LVT},
+	{0xC3A0, 0xC3A0, gcb_LV},
+	{0xC3A1, 0xC3BB, gcb_LVT},
+	{0xC3BC, 0xC3BC, gcb_LV},
+	{0xC3BD, 0xC3D7, gcb_LVT},
+	{0xC3D8, 0xC3D8, gcb_LV},
+	{0xC3D9, 0xC3F3, gcb_LVT},
+	{0xC3F4, 0xC3F4, gcb_LV},
+	{0xC3F5, 0xC40F, gcb_LVT},
+	{0xC410, 0xC410, gcb_LV},
+	{0xC411, 0xC42B, gcb_LVT},
+	{0xC42C, 0xC42C, gcb_LV},
+	{0xC42D, 0xC447, gcb_LVT},
+	{0xC448, 0xC448, gcb_LV},
+	{0xC449, 0xC463, gcb_LVT},
+	{0xC464, 0xC464, gcb_LV},
+	{0xC465, 0xC47F, gcb_LVT},
+	{0xC480, 0xC480, gcb_LV},
+	{0xC481, 0xC49B, gcb_LVT},
+	{0xC49C, 0xC49C, gcb_LV},
+	{0xC49D, 0xC4B7, gcb_LVT},
+	{0xC4B8, 0xC4B8, gcb_LV},
+	{0xC4B9, 0xC4D3, gcb_LVT},
+	{0xC4D4, 0xC4D4, gcb_LV},
+	{0xC4D5, 0xC4EF, gcb_LVT},
+	{0xC4F0, 0xC4F0, gcb_LV},
+	{0xC4F1, 0xC50B, gcb_LVT},
+	{0xC50C, 0xC50C, gcb_LV},
+	{0xC50D, 0xC527, gcb_LVT},
+	{0xC528, 0xC528, gcb_LV},
+	{0xC529, 0xC543, gcb_LVT},
+	{0xC544, 0xC544, gcb_LV},
+	{0xC545, 0xC55F, gcb_LVT},
+	{0xC560, 0xC560, gcb_LV},
+	{0xC561, 0xC57B, gcb_LVT},
+	{0xC57C, 0xC57C, gcb_LV},
+	{0xC57D, 0xC597, gcb_LVT},
+	{0xC598, 0xC598, gcb_LV},
+	{0xC599, 0xC5B3, gcb_LVT},
+	{0xC5B4, 0xC5B4, gcb_LV},
+	{0xC5B5, 0xC5CF, gcb_LVT},
+	{0xC5D0, 0xC5D0, gcb_LV},
+	{0xC5D1, 0xC5EB, gcb_LVT},
+	{0xC5EC, 0xC5EC, gcb_LV},
+	{0xC5ED, 0xC607, gcb_LVT},
+	{0xC608, 0xC608, gcb_LV},
+	{0xC609, 0xC623, gcb_LVT},
+	{0xC624, 0xC624, gcb_LV},
+	{0xC625, 0xC63F, gcb_LVT},
+	{0xC640, 0xC640, gcb_LV},
+	{0xC641, 0xC65B, gcb_LVT},
+	{0xC65C, 0xC65C, gcb_LV},
+	{0xC65D, 0xC677, gcb_LVT},
+	{0xC678, 0xC678, gcb_LV},
+	{0xC679, 0xC693, gcb_LVT},
+	{0xC694, 0xC694, gcb_LV},
+	{0xC695, 0xC6AF, gcb_LVT},
+	{0xC6B0, 0xC6B0, gcb_LV},
+	{0xC6B1, 0xC6CB, gcb_LVT},
+	{0xC6CC, 0xC6CC, gcb_LV},
+	{0xC6CD, 0xC6E7, gcb_LVT},
+	{0xC6E8, 0xC6E8, gcb_LV},
+	{0xC6E9, 0xC703, gcb_LVT},
+	{0xC704, 0xC704, gcb_LV},
+	{0xC705, 0xC71F, gcb_LVT},
+	{0xC720, 0xC720, gcb_LV},
+	{0xC721, 0xC73B, gcb_LVT},
+	{0xC73C, 0xC73C, gcb_LV},
+	{0xC73D, 0xC757, gcb_LVT},
+	{0xC758, 0xC758, gcb_LV},
+	{0xC759, 0xC773, gcb_LVT},
+	{0xC774, 0xC774, gcb_LV},
+	{0xC775, 0xC78F, gcb_LVT},
+	{0xC790, 0xC790, gcb_LV},
+	{0xC791, 0xC7AB, gcb_LVT},
+	{0xC7AC, 0xC7AC, gcb_LV},
+	{0xC7AD, 0xC7C7, gcb_LVT},
+	{0xC7C8, 0xC7C8, gcb_LV},
+	{0xC7C9, 0xC7E3, gcb_LVT},
+	{0xC7E4, 0xC7E4, gcb_LV},
+	{0xC7E5, 0xC7FF, gcb_LVT},
+	{0xC800, 0xC800, gcb_LV},
+	{0xC801, 0xC81B, gcb_LVT},
+	{0xC81C, 0xC81C, gcb_LV},
+	{0xC81D, 0xC837, gcb_LVT},
+	{0xC838, 0xC838, gcb_LV},
+	{0xC839, 0xC853, gcb_LVT},
+	{0xC854, 0xC854, gcb_LV},
+	{0xC855, 0xC86F, gcb_LVT},
+	{0xC870, 0xC870, gcb_LV},
+	{0xC871, 0xC88B, gcb_LVT},
+	{0xC88C, 0xC88C, gcb_LV},
+	{0xC88D, 0xC8A7, gcb_LVT},
+	{0xC8A8, 0xC8A8, gcb_LV},
+	{0xC8A9, 0xC8C3, gcb_LVT},
+	{0xC8C4, 0xC8C4, gcb_LV},
+	{0xC8C5, 0xC8DF, gcb_LVT},
+	{0xC8E0, 0xC8E0, gcb_LV},
+	{0xC8E1, 0xC8FB, gcb_LVT},
+	{0xC8FC, 0xC8FC, gcb_LV},
+	{0xC8FD, 0xC917, gcb_LVT},
+	{0xC918, 0xC918, gcb_LV},
+	{0xC919, 0xC933, gcb_LVT},
+	{0xC934, 0xC934, gcb_LV},
+	{0xC935, 0xC94F, gcb_LVT},
+	{0xC950, 0xC950, gcb_LV},
+	{0xC951, 0xC96B, gcb_LVT},
+	{0xC96C, 0xC96C, gcb_LV},
+	{0xC96D, 0xC987, gcb_LVT},
+	{0xC988, 0xC988, gcb_LV},
+	{0xC989, 0xC9A3, gcb_LVT},
+	{0xC9A4, 0xC9A4, gcb_LV},
+	{0xC9A5, 0xC9BF, gcb_LVT},
+	{0xC9C0, 0xC9C0, gcb_LV},
+	{0xC9C1, 0xC9DB, gcb_LVT},
+	{0xC9DC, 0xC9DC, gcb_LV},
+	{0xC9DD, 0xC9F7, gcb_LVT},
+	{0xC9F8, 0xC9F8, gcb_LV},
+	{0xC9F9, 0xCA13, gcb_LVT},
+	{0xCA14, 0xCA14, gcb_LV},
+	{0xCA15, 0xCA2F, gcb_LVT},
+	{0xCA30, 0xCA30, gcb_LV},
+	{0xCA31, 0xCA4B, gcb_LVT},
+	{0xCA4C, 0xCA4C, gcb_LV},
+	{0xCA4D, 0xCA67, gcb_LVT},
+	{0xCA68, 0xCA68, gcb_LV},
+	{0xCA69, 0xCA83, gcb_LVT},
+	{0xCA84, 0xCA84, gcb_LV},
+	{0xCA85, 0xCA9F, gcb_LVT},
+	{0xCAA0, 0xCAA0, gcb_LV},
+	{0xCAA1, 0xCABB, gcb_LVT},
+	{0xCABC, 0xCABC, gcb_LV},
+	{0xCABD, 0xCAD7, gcb_LVT},
+	{0xCAD8, 0xCAD8, gcb_LV},
+	{0xCAD9, 0xCAF3, gcb_LVT},
+	{0xCAF4, 0xCAF4, gcb_LV},
+	{0xCAF5, 0xCB0F, gcb_LVT},
+	{0xCB10, 0xCB10, gcb_LV},
+	{0xCB11, 0xCB2B, gcb_LVT},
+	{0xCB2C, 0xCB2C, gcb_LV},
+	{0xCB2D, 0xCB47, gcb_LVT},
+	{0xCB48, 0xCB48, gcb_LV},
+	{0xCB49, 0xCB63, gcb_LVT},
+	{0xCB64, 0xCB64, gcb_LV},
+	{0xCB65, 0xCB7F, gcb_LVT},
+	{0xCB80, 0xCB80, gcb_LV},
+	{0xCB81, 0xCB9B, gcb_LVT},
+	{0xCB9C, 0xCB9C, gcb_LV},
+	{0xCB9D, 0xCBB7, gcb_LVT},
+	{0xCBB8, 0xCBB8, gcb_LV},
+	{0xCBB9, 0xCBD3, gcb_LVT},
+	{0xCBD4, 0xCBD4, gcb_LV},
+	{0xCBD5, 0xCBEF, gcb_LVT},
+	{0xCBF0, 0xCBF0, gcb_LV},
+	{0xCBF1, 0xCC0B, gcb_LVT},
+	{0xCC0C, 0xCC0C, gcb_LV},
+	{0xCC0D, 0xCC27, gcb_LVT},
+	{0xCC28, 0xCC28, gcb_LV},
+	{0xCC29, 0xCC43, gcb_LVT},
+	{0xCC44, 0xCC44, gcb_LV},
+	{0xCC45, 0xCC5F, gcb_LVT},
+	{0xCC60, 0xCC60, gcb_LV},
+	{0xCC61, 0xCC7B, gcb_LVT},
+	{0xCC7C, 0xCC7C, gcb_LV},
+	{0xCC7D, 0xCC97, gcb_LVT},
+	{0xCC98, 0xCC98, gcb_LV},
+	{0xCC99, 0xCCB3, gcb_LVT},
+	{0xCCB4, 0xCCB4, gcb_LV},
+	{0xCCB5, 0xCCCF, gcb_LVT},
+	{0xCCD0, 0xCCD0, gcb_LV},
+	{0xCCD1, 0xCCEB, gcb_LVT},
+	{0xCCEC, 0xCCEC, gcb_LV},
+	{0xCCED, 0xCD07, gcb_LVT},
+	{0xCD08, 0xCD08, gcb_LV},
+	{0xCD09, 0xCD23, gcb_LVT},
+	{0xCD24, 0xCD24, gcb_LV},
+	{0xCD25, 0xCD3F, gcb_LVT},
+	{0xCD40, 0xCD40, gcb_LV},
+	{0xCD41, 0xCD5B, gcb_LVT},
+	{0xCD5C, 0xCD5C, gcb_LV},
+	{0xCD5D, 0xCD77, gcb_LVT},
+	{0xCD78, 0xCD78, gcb_LV},
+	{0xCD79, 0xCD93, gcb_LVT},
+	{0xCD94, 0xCD94, gcb_LV},
+	{0xCD95, 0xCDAF, gcb_LVT},
+	{0xCDB0, 0xCDB0, gcb_LV},
+	{0xCDB1, 0xCDCB, gcb_LVT},
+	{0xCDCC, 0xCDCC, gcb_LV},
+	{0xCDCD, 0xCDE7, gcb_LVT},
+	{0xCDE8, 0xCDE8, gcb_LV},
+	{0xCDE9, 0xCE03, gcb_LVT},
+	{0xCE04, 0xCE04, gcb_LV},
+	{0xCE05, 0xCE1F, gcb_LVT},
+	{0xCE20, 0xCE20, gcb_LV},
+	{0xCE21, 0xCE3B, gcb_LVT},
+	{0xCE3C, 0xCE3C, gcb_LV},
+	{0xCE3D, 0xCE57, gcb_LVT},
+	{0xCE58, 0xCE58, gcb_LV},
+	{0xCE59, 0xCE73, gcb_LVT},
+	{0xCE74, 0xCE74, gcb_LV},
+	{0xCE75, 0xCE8F, gcb_LVT},
+	{0xCE90, 0xCE90, gcb_LV},
+	{0xCE91, 0xCEAB, gcb_LVT},
+	{0xCEAC, 0xCEAC, gcb_LV},
+	{0xCEAD, 0xCEC7, gcb_LVT},
+	{0xCEC8, 0xCEC8, gcb_LV},
+	{0xCEC9, 0xCEE3, gcb_LVT},
+	{0xCEE4, 0xCEE4, gcb_LV},
+	{0xCEE5, 0xCEFF, gcb_LVT},
+	{0xCF00, 0xCF00, gcb_LV},
+	{0xCF01, 0xCF1B, gcb_LVT},
+	{0xCF1C, 0xCF1C, gcb_LV},
+	{0xCF1D, 0xCF37, gcb_LVT},
+	{0xCF38, 0xCF38, gcb_LV},
+	{0xCF39, 0xCF53, gcb_LVT},
+	{0xCF54, 0xCF54, gcb_LV},
+	{0xCF55, 0xCF6F, gcb_LVT},
+	{0xCF70, 0xCF70, gcb_LV},
+	{0xCF71, 0xCF8B, gcb_LVT},
+	{0xCF8C, 0xCF8C, gcb_LV},
+	{0xCF8D, 0xCFA7, gcb_LVT},
+	{0xCFA8, 0xCFA8, gcb_LV},
+	{0xCFA9, 0xCFC3, gcb_LVT},
+	{0xCFC4, 0xCFC4, gcb_LV},
+	{0xCFC5, 0xCFDF, gcb_LVT},
+	{0xCFE0, 0xCFE0, gcb_LV},
+	{0xCFE1, 0xCFFB, gcb_LVT},
+	{0xCFFC, 0xCFFC, gcb_LV},
+	{0xCFFD, 0xD017, gcb_LVT},
+	{0xD018, 0xD018, gcb_LV},
+	{0xD019, 0xD033, gcb_LVT},
+	{0xD034, 0xD034, gcb_LV},
+	{0xD035, 0xD04F, gcb_LVT},
+	{0xD050, 0xD050, gcb_LV},
+	{0xD051, 0xD06B, gcb_LVT},
+	{0xD06C, 0xD06C, gcb_LV},
+	{0xD06D, 0xD087, gcb_LVT},
+	{0xD088, 0xD088, gcb_LV},
+	{0xD089, 0xD0A3, gcb_LVT},
+	{0xD0A4, 0xD0A4, gcb_LV},
+	{0xD0A5, 0xD0BF, gcb_LVT},
+	{0xD0C0, 0xD0C0, gcb_LV},
+	{0xD0C1, 0xD0DB, gcb_LVT},
+	{0xD0DC, 0xD0DC, gcb_LV},
+	{0xD0DD, 0xD0F7, gcb_LVT},
+	{0xD0F8, 0xD0F8, gcb_LV},
+	{0xD0F9, 0xD113, gcb_LVT},
+	{0xD114, 0xD114, gcb_LV},
+	{0xD115, 0xD12F, gcb_LVT},
+	{0xD130, 0xD130, gcb_LV},
+	{0xD131, 0xD14B, gcb_LVT},
+	{0xD14C, 0xD14C, gcb_LV},
+	{0xD14D, 0xD167, gcb_LVT},
+	{0xD168, 0xD168, gcb_LV},
+	{0xD169, 0xD183, gcb_LVT},
+	{0xD184, 0xD184, gcb_LV},
+	{0xD185, 0xD19F, gcb_LVT},
+	{0xD1A0, 0xD1A0, gcb_LV},
+	{0xD1A1, 0xD1BB, gcb_LVT},
+	{0xD1BC, 0xD1BC, gcb_LV},
+	{0xD1BD, 0xD1D7, gcb_LVT},
+	{0xD1D8, 0xD1D8, gcb_LV},
+	{0xD1D9, 0xD1F3, gcb_LVT},
+	{0xD1F4, 0xD1F4, gcb_LV},
+	{0xD1F5, 0xD20F, gcb_LVT},
+	{0xD210, 0xD210, gcb_LV},
+	{0xD211, 0xD22B, gcb_LVT},
+	{0xD22C, 0xD22C, gcb_LV},
+	{0xD22D, 0xD247, gcb_LVT},
+	{0xD248, 0xD248, gcb_LV},
+	{0xD249, 0xD263, gcb_LVT},
+	{0xD264, 0xD264, gcb_LV},
+	{0xD265, 0xD27F, gcb_LVT},
+	{0xD280, 0xD280, gcb_LV},
+	{0xD281, 0xD29B, gcb_LVT},
+	{0xD29C, 0xD29C, gcb_LV},
+	{0xD29D, 0xD2B7, gcb_LVT},
+	{0xD2B8, 0xD2B8, gcb_LV},
+	{0xD2B9, 0xD2D3, gcb_LVT},
+	{0xD2D4, 0xD2D4, gcb_LV},
+	{0xD2D5, 0xD2EF, gcb_LVT},
+	{0xD2F0, 0xD2F0, gcb_LV},
+	{0xD2F1, 0xD30B, gcb_LVT},
+	{0xD30C, 0xD30C, gcb_LV},
+	{0xD30D, 0xD327, gcb_LVT},
+	{0xD328, 0xD328, gcb_LV},
+	{0xD329, 0xD343, gcb_LVT},
+	{0xD344, 0xD344, gcb_LV},
+	{0xD345, 0xD35F, gcb_LVT},
+	{0xD360, 0xD360, gcb_LV},
+	{0xD361, 0xD37B, gcb_LVT},
+	{0xD37C, 0xD37C, gcb_LV},
+	{0xD37D, 0xD397, gcb_LVT},
+	{0xD398, 0xD398, gcb_LV},
+	{0xD399, 0xD3B3, gcb_LVT},
+	{0xD3B4, 0xD3B4, gcb_LV},
+	{0xD3B5, 0xD3CF, gcb_LVT},
+	{0xD3D0, 0xD3D0, gcb_LV},
+	{0xD3D1, 0xD3EB, gcb_LVT},
+	{0xD3EC, 0xD3EC, gcb_LV},
+	{0xD3ED, 0xD407, gcb_LVT},
+	{0xD408, 0xD408, gcb_LV},
+	{0xD409, 0xD423, gcb_LVT},
+	{0xD424, 0xD424, gcb_LV},
+	{0xD425, 0xD43F, gcb_LVT},
+	{0xD440, 0xD440, gcb_LV},
+	{0xD441, 0xD45B, gcb_LVT},
+	{0xD45C, 0xD45C, gcb_LV},
+	{0xD45D, 0xD477, gcb_LVT},
+	{0xD478, 0xD478, gcb_LV},
+	{0xD479, 0xD493, gcb_LVT},
+	{0xD494, 0xD494, gcb_LV},
+	{0xD495, 0xD4AF, gcb_LVT},
+	{0xD4B0, 0xD4B0, gcb_LV},
+	{0xD4B1, 0xD4CB, gcb_LVT},
+	{0xD4CC, 0xD4CC, gcb_LV},
+	{0xD4CD, 0xD4E7, gcb_LVT},
+	{0xD4E8, 0xD4E8, gcb_LV},
+	{0xD4E9, 0xD503, gcb_LVT},
+	{0xD504, 0xD504, gcb_LV},
+	{0xD505, 0xD51F, gcb_LVT},
+	{0xD520, 0xD520, gcb_LV},
+	{0xD521, 0xD53B, gcb_LVT},
+	{0xD53C, 0xD53C, gcb_LV},
+	{0xD53D, 0xD557, gcb_LVT},
+	{0xD558, 0xD558, gcb_LV},
+	{0xD559, 0xD573, gcb_LVT},
+	{0xD574, 0xD574, gcb_LV},
+	{0xD575, 0xD58F, gcb_LVT},
+	{0xD590, 0xD590, gcb_LV},
+	{0xD591, 0xD5AB, gcb_LVT},
+	{0xD5AC, 0xD5AC, gcb_LV},
+	{0xD5AD, 0xD5C7, gcb_LVT},
+	{0xD5C8, 0xD5C8, gcb_LV},
+	{0xD5C9, 0xD5E3, gcb_LVT},
+	{0xD5E4, 0xD5E4, gcb_LV},
+	{0xD5E5, 0xD5FF, gcb_LVT},
+	{0xD600, 0xD600, gcb_LV},
+	{0xD601, 0xD61B, gcb_LVT},
+	{0xD61C, 0xD61C, gcb_LV},
+	{0xD61D, 0xD637, gcb_LVT},
+	{0xD638, 0xD638, gcb_LV},
+	{0xD639, 0xD653, gcb_LVT},
+	{0xD654, 0xD654, gcb_LV},
+	{0xD655, 0xD66F, gcb_LVT},
+	{0xD670, 0xD670, gcb_LV},
+	{0xD671, 0xD68B, gcb_LVT},
+	{0xD68C, 0xD68C, gcb_LV},
+	{0xD68D, 0xD6A7, gcb_LVT},
+	{0xD6A8, 0xD6A8, gcb_LV},
+	{0xD6A9, 0xD6C3, gcb_LVT},
+	{0xD6C4, 0xD6C4, gcb_LV},
+	{0xD6C5, 0xD6DF, gcb_LVT},
+	{0xD6E0, 0xD6E0, gcb_LV},
+	{0xD6E1, 0xD6FB, gcb_LVT},
+	{0xD6FC, 0xD6FC, gcb_LV},
+	{0xD6FD, 0xD717, gcb_LVT},
+	{0xD718, 0xD718, gcb_LV},
+	{0xD719, 0xD733, gcb_LVT},
+	{0xD734, 0xD734, gcb_LV},
+	{0xD735, 0xD74F, gcb_LVT},
+	{0xD750, 0xD750, gcb_LV},
+	{0xD751, 0xD76B, gcb_LVT},
+	{0xD76C, 0xD76C, gcb_LV},
+	{0xD76D, 0xD787, gcb_LVT},
+	{0xD788, 0xD788, gcb_LV},
+	{0xD789, 0xD7A3, gcb_LVT},
+	{0xD7B0, 0xD7C6, gcb_V},
+	{0xD7CB, 0xD7FB, gcb_T},
+	{0xFB1E, 0xFB1E, gcb_Extend},
+	{0xFE00, 0xFE0F, gcb_Extend},
+	{0xFE20, 0xFE2F, gcb_Extend},
+	{0xFEFF, 0xFEFF, gcb_Control},
+	{0xFF9E, 0xFF9F, gcb_Extend},
+	{0xFFF0, 0xFFFB, gcb_Control},
+	{0x101FD, 0x101FD, gcb_Extend},
+	{0x102E0, 0x102E0, gcb_Extend},
+	{0x10376, 0x1037A, gcb_Extend},
+	{0x10A01, 0x10A03, gcb_Extend},
+	{0x10A05, 0x10A06, gcb_Extend},
+	{0x10A0C, 0x10A0F, gcb_Extend},
+	{0x10A38, 0x10A3A, gcb_Extend},
+	{0x10A3F, 0x10A3F, gcb_Extend},
+	{0x10AE5, 0x10AE6, gcb_Extend},
+	{0x10D24, 0x10D27, gcb_Extend},
+	{0x10D69, 0x10D6D, gcb_Extend},
+	{0x10EAB, 0x10EAC, gcb_Extend},
+	{0x10EFA, 0x10EFF, gcb_Extend},
+	{0x10F46, 0x10F50, gcb_Extend},
+	{0x10F82, 0x10F85, gcb_Extend},
+	{0x11000, 0x11000, gcb_SpacingMark},
+	{0x11001, 0x11001, gcb_Extend},
+	{0x11002, 0x11002, gcb_SpacingMark},
+	{0x11038, 0x11046, gcb_Extend},
+	{0x11070, 0x11070, gcb_Extend},
+	{0x11073, 0x11074, gcb_Extend},
+	{0x1107F, 0x11081, gcb_Extend},
+	{0x11082, 0x11082, gcb_SpacingMark},
+	{0x110B0, 0x110B2, gcb_SpacingMark},
+	{0x110B3, 0x110B6, gcb_Extend},
+	{0x110B7, 0x110B8, gcb_SpacingMark},
+	{0x110B9, 0x110BA, gcb_Extend},
+	{0x110BD, 0x110BD, gcb_Prepend},
+	{0x110C2, 0x110C2, gcb_Extend},
+	{0x110CD, 0x110CD, gcb_Prepend},
+	{0x11100, 0x11102, gcb_Extend},
+	{0x11127, 0x1112B, gcb_Extend},
+	{0x1112C, 0x1112C, gcb_SpacingMark},
+	{0x1112D, 0x11134, gcb_Extend},
+	{0x11145, 0x11146, gcb_SpacingMark},
+	{0x11173, 0x11173, gcb_Extend},
+	{0x11180, 0x11181, gcb_Extend},
+	{0x11182, 0x11182, gcb_SpacingMark},
+	{0x111B3, 0x111B5, gcb_SpacingMark},
+	{0x111B6, 0x111BE, gcb_Extend},
+	{0x111BF, 0x111BF, gcb_SpacingMark},
+	{0x111C0, 0x111C0, gcb_Extend},
+	{0x111C2, 0x111C3, gcb_Prepend},
+	{0x111C9, 0x111CC, gcb_Extend},
+	{0x111CE, 0x111CE, gcb_SpacingMark},
+	{0x111CF, 0x111CF, gcb_Extend},
+	{0x1122C, 0x1122E, gcb_SpacingMark},
+	{0x1122F, 0x11231, gcb_Extend},
+	{0x11232, 0x11233, gcb_SpacingMark},
+	{0x11234, 0x11237, gcb_Extend},
+	{0x1123E, 0x1123E, gcb_Extend},
+	{0x11241, 0x11241, gcb_Extend},
+	{0x112DF, 0x112DF, gcb_Extend},
+	{0x112E0, 0x112E2, gcb_SpacingMark},
+	{0x112E3, 0x112EA, gcb_Extend},
+	{0x11300, 0x11301, gcb_Extend},
+	{0x11302, 0x11303, gcb_SpacingMark},
+	{0x1133B, 0x1133C, gcb_Extend},
+	{0x1133E, 0x1133E, gcb_Extend},
+	{0x1133F, 0x1133F, gcb_SpacingMark},
+	{0x11340, 0x11340, gcb_Extend},
+	{0x11341, 0x11344, gcb_SpacingMark},
+	{0x11347, 0x11348, gcb_SpacingMark},
+	{0x1134B, 0x1134C, gcb_SpacingMark},
+	{0x1134D, 0x1134D, gcb_Extend},
+	{0x11357, 0x11357, gcb_Extend},
+	{0x11362, 0x11363, gcb_SpacingMark},
+	{0x11366, 0x1136C, gcb_Extend},
+	{0x11370, 0x11374, gcb_Extend},
+	{0x113B8, 0x113B8, gcb_Extend},
+	{0x113B9, 0x113BA, gcb_SpacingMark},
+	{0x113BB, 0x113C0, gcb_Extend},
+	{0x113C2, 0x113C2, gcb_Extend},
+	{0x113C5, 0x113C5, gcb_Extend},
+	{0x113C7, 0x113C9, gcb_Extend},
+	{0x113CA, 0x113CA, gcb_SpacingMark},
+	{0x113CC, 0x113CD, gcb_SpacingMark},
+	{0x113CE, 0x113D0, gcb_Extend},
+	{0x113D1, 0x113D1, gcb_Prepend},
+	{0x113D2, 0x113D2, gcb_Extend},
+	{0x113E1, 0x113E2, gcb_Extend},
+	{0x11435, 0x11437, gcb_SpacingMark},
+	{0x11438, 0x1143F, gcb_Extend},
+	{0x11440, 0x11441, gcb_SpacingMark},
+	{0x11442, 0x11444, gcb_Extend},
+	{0x11445, 0x11445, gcb_SpacingMark},
+	{0x11446, 0x11446, gcb_Extend},
+	{0x1145E, 0x1145E, gcb_Extend},
+	{0x114B0, 0x114B0, gcb_Extend},
+	{0x114B1, 0x114B2, gcb_SpacingMark},
+	{0x114B3, 0x114B8, gcb_Extend},
+	{0x114B9, 0x114B9, gcb_SpacingMark},
+	{0x114BA, 0x114BA, gcb_Extend},
+	{0x114BB, 0x114BC, gcb_SpacingMark},
+	{0x114BD, 0x114BD, gcb_Extend},
+	{0x114BE, 0x114BE, gcb_SpacingMark},
+	{0x114BF, 0x114C0, gcb_Extend},
+	{0x114C1, 0x114C1, gcb_SpacingMark},
+	{0x114C2, 0x114C3, gcb_Extend},
+	{0x115AF, 0x115AF, gcb_Extend},
+	{0x115B0, 0x115B1, gcb_SpacingMark},
+	{0x115B2, 0x115B5, gcb_Extend},
+	{0x115B8, 0x115BB, gcb_SpacingMark},
+	{0x115BC, 0x115BD, gcb_Extend},
+	{0x115BE, 0x115BE, gcb_SpacingMark},
+	{0x115BF, 0x115C0, gcb_Extend},
+	{0x115DC, 0x115DD, gcb_Extend},
+	{0x11630, 0x11632, gcb_SpacingMark},
+	{0x11633, 0x1163A, gcb_Extend},
+	{0x1163B, 0x1163C, gcb_SpacingMark},
+	{0x1163D, 0x1163D, gcb_Extend},
+	{0x1163E, 0x1163E, gcb_SpacingMark},
+	{0x1163F, 0x11640, gcb_Extend},
+	{0x116AB, 0x116AB, gcb_Extend},
+	{0x116AC, 0x116AC, gcb_SpacingMark},
+	{0x116AD, 0x116AD, gcb_Extend},
+	{0x116AE, 0x116AF, gcb_SpacingMark},
+	{0x116B0, 0x116B7, gcb_Extend},
+	{0x1171D, 0x1171D, gcb_Extend},
+	{0x1171E, 0x1171E, gcb_SpacingMark},
+	{0x1171F, 0x1171F, gcb_Extend},
+	{0x11722, 0x11725, gcb_Extend},
+	{0x11726, 0x11726, gcb_SpacingMark},
+	{0x11727, 0x1172B, gcb_Extend},
+	{0x1182C, 0x1182E, gcb_SpacingMark},
+	{0x1182F, 0x11837, gcb_Extend},
+	{0x11838, 0x11838, gcb_SpacingMark},
+	{0x11839, 0x1183A, gcb_Extend},
+	{0x11930, 0x11930, gcb_Extend},
+	{0x11931, 0x11935, gcb_SpacingMark},
+	{0x11937, 0x11938, gcb_SpacingMark},
+	{0x1193B, 0x1193E, gcb_Extend},
+	{0x1193F, 0x1193F, gcb_Prepend},
+	{0x11940, 0x11940, gcb_SpacingMark},
+	{0x11941, 0x11941, gcb_Prepend},
+	{0x11942, 0x11942, gcb_SpacingMark},
+	{0x11943, 0x11943, gcb_Extend},
+	{0x119D1, 0x119D3, gcb_SpacingMark},
+	{0x119D4, 0x119D7, gcb_Extend},
+	{0x119DA, 0x119DB, gcb_Extend},
+	{0x119DC, 0x119DF, gcb_SpacingMark},
+	{0x119E0, 0x119E0, gcb_Extend},
+	{0x119E4, 0x119E4, gcb_SpacingMark},
+	{0x11A01, 0x11A0A, gcb_Extend},
+	{0x11A33, 0x11A38, gcb_Extend},
+	{0x11A39, 0x11A39, gcb_SpacingMark},
+	{0x11A3B, 0x11A3E, gcb_Extend},
+	{0x11A47, 0x11A47, gcb_Extend},
+	{0x11A51, 0x11A56, gcb_Extend},
+	{0x11A57, 0x11A58, gcb_SpacingMark},
+	{0x11A59, 0x11A5B, gcb_Extend},
+	{0x11A84, 0x11A89, gcb_Prepend},
+	{0x11A8A, 0x11A96, gcb_Extend},
+	{0x11A97, 0x11A97, gcb_SpacingMark},
+	{0x11A98, 0x11A99, gcb_Extend},
+	{0x11B60, 0x11B60, gcb_Extend},
+	{0x11B61, 0x11B61, gcb_SpacingMark},
+	{0x11B62, 0x11B64, gcb_Extend},
+	{0x11B65, 0x11B65, gcb_SpacingMark},
+	{0x11B66, 0x11B66, gcb_Extend},
+	{0x11B67, 0x11B67, gcb_SpacingMark},
+	{0x11C2F, 0x11C2F, gcb_SpacingMark},
+	{0x11C30, 0x11C36, gcb_Extend},
+	{0x11C38, 0x11C3D, gcb_Extend},
+	{0x11C3E, 0x11C3E, gcb_SpacingMark},
+	{0x11C3F, 0x11C3F, gcb_Extend},
+	{0x11C92, 0x11CA7, gcb_Extend},
+	{0x11CA9, 0x11CA9, gcb_SpacingMark},
+	{0x11CAA, 0x11CB0, gcb_Extend},
+	{0x11CB1, 0x11CB1, gcb_SpacingMark},
+	{0x11CB2, 0x11CB3, gcb_Extend},
+	{0x11CB4, 0x11CB4, gcb_SpacingMark},
+	{0x11CB5, 0x11CB6, gcb_Extend},
+	{0x11D31, 0x11D36, gcb_Extend},
+	{0x11D3A, 0x11D3A, gcb_Extend},
+	{0x11D3C, 0x11D3D, gcb_Extend},
+	{0x11D3F, 0x11D45, gcb_Extend},
+	{0x11D46, 0x11D46, gcb_Prepend},
+	{0x11D47, 0x11D47, gcb_Extend},
+	{0x11D8A, 0x11D8E, gcb_SpacingMark},
+	{0x11D90, 0x11D91, gcb_Extend},
+	{0x11D93, 0x11D94, gcb_SpacingMark},
+	{0x11D95, 0x11D95, gcb_Extend},
+	{0x11D96, 0x11D96, gcb_SpacingMark},
+	{0x11D97, 0x11D97, gcb_Extend},
+	{0x11EF3, 0x11EF4, gcb_Extend},
+	{0x11EF5, 0x11EF6, gcb_SpacingMark},
+	{0x11F00, 0x11F01, gcb_Extend},
+	{0x11F02, 0x11F02, gcb_Prepend},
+	{0x11F03, 0x11F03, gcb_SpacingMark},
+	{0x11F34, 0x11F35, gcb_SpacingMark},
+	{0x11F36, 0x11F3A, gcb_Extend},
+	{0x11F3E, 0x11F3F, gcb_SpacingMark},
+	{0x11F40, 0x11F42, gcb_Extend},
+	{0x11F5A, 0x11F5A, gcb_Extend},
+	{0x13430, 0x1343F, gcb_Control},
+	{0x13440, 0x13440, gcb_Extend},
+	{0x13447, 0x13455, gcb_Extend},
+	{0x1611E, 0x16129, gcb_Extend},
+	{0x1612A, 0x1612C, gcb_SpacingMark},
+	{0x1612D, 0x1612F, gcb_Extend},
+	{0x16AF0, 0x16AF4, gcb_Extend},
+	{0x16B30, 0x16B36, gcb_Extend},
+	{0x16D63, 0x16D63, gcb_V},
+	{0x16D67, 0x16D6A, gcb_V},
+	{0x16F4F, 0x16F4F, gcb_Extend},
+	{0x16F51, 0x16F87, gcb_SpacingMark},
+	{0x16F8F, 0x16F92, gcb_Extend},
+	{0x16FE4, 0x16FE4, gcb_Extend},
+	{0x16FF0, 0x16FF1, gcb_Extend},
+	{0x1BC9D, 0x1BC9E, gcb_Extend},
+	{0x1BCA0, 0x1BCA3, gcb_Control},
+	{0x1CF00, 0x1CF2D, gcb_Extend},
+	{0x1CF30, 0x1CF46, gcb_Extend},
+	{0x1D165, 0x1D169, gcb_Extend},
+	{0x1D16D, 0x1D172, gcb_Extend},
+	{0x1D173, 0x1D17A, gcb_Control},
+	{0x1D17B, 0x1D182, gcb_Extend},
+	{0x1D185, 0x1D18B, gcb_Extend},
+	{0x1D1AA, 0x1D1AD, gcb_Extend},
+	{0x1D242, 0x1D244, gcb_Extend},
+	{0x1DA00, 0x1DA36, gcb_Extend},
+	{0x1DA3B, 0x1DA6C, gcb_Extend},
+	{0x1DA75, 0x1DA75, gcb_Extend},
+	{0x1DA84, 0x1DA84, gcb_Extend},
+	{0x1DA9B, 0x1DA9F, gcb_Extend},
+	{0x1DAA1, 0x1DAAF, gcb_Extend},
+	{0x1E000, 0x1E006, gcb_Extend},
+	{0x1E008, 0x1E018, gcb_Extend},
+	{0x1E01B, 0x1E021, gcb_Extend},
+	{0x1E023, 0x1E024, gcb_Extend},
+	{0x1E026, 0x1E02A, gcb_Extend},
+	{0x1E08F, 0x1E08F, gcb_Extend},
+	{0x1E130, 0x1E136, gcb_Extend},
+	{0x1E2AE, 0x1E2AE, gcb_Extend},
+	{0x1E2EC, 0x1E2EF, gcb_Extend},
+	{0x1E4EC, 0x1E4EF, gcb_Extend},
+	{0x1E5EE, 0x1E5EF, gcb_Extend},
+	{0x1E6E3, 0x1E6E3, gcb_Extend},
+	{0x1E6E6, 0x1E6E6, gcb_Extend},
+	{0x1E6EE, 0x1E6EF, gcb_Extend},
+	{0x1E6F5, 0x1E6F5, gcb_Extend},
+	{0x1E8D0, 0x1E8D6, gcb_Extend},
+	{0x1E944, 0x1E94A, gcb_Extend},
+	{0x1F1E6, 0x1F1FF, gcb_RI},
+	{0x1F3FB, 0x1F3FF, gcb_Extend},
+	{0xE0000, 0xE001F, gcb_Control},
+	{0xE0020, 0xE007F, gcb_Extend},
+	{0xE0080, 0xE00FF, gcb_Control},
+	{0xE0100, 0xE01EF, gcb_Extend},
+	{0xE01F0, 0xE0FFF, gcb_Control},
+}
+
+// extPictTable is the table of the runes which have the Extended_Pictographic
+// property, which is sorted by code points.
+var extPictTable = [][2]rune{
+	{0x00A9, 0x00A9},
+	{0x00AE, 0x00AE},
+	{0x203C, 0x203C},
+	{0x2049, 0x2049},
+	{0x2122, 0x2122},
+	{0x2139, 0x2139},
+	{0x2194, 0x2199},
+	{0x21A9, 0x21AA},
+	{0x231A, 0x231B},
+	{0x2328, 0x2328},
+	{0x23CF, 0x23CF},
+	{0x23E9, 0x23F3},
+	{0x23F8, 0x23FA},
+	{0x24C2, 0x24C2},
+	{0x25AA, 0x25AB},
+	{0x25B6, 0x25B6},
+	{0x25C0, 0x25C0},
+	{0x25FB, 0x25FE},
+	{0x2600, 0x2604},
+	{0x260E, 0x260E},
+	{0x2611, 0x2611},
+	{0x2614, 0x2615},
+	{0x2618, 0x2618},
+	{0x261D, 0x261D},
+	{0x2620, 0x2620},
+	{0x2622, 0x2623},
+	{0x2626, 0x2626},
+	{0x262A, 0x262A},
+	{0x262E, 0x262F},
+	{0x2638, 0x263A},
+	{0x2640, 0x2640},
+	{0x2642, 0x2642},
+	{0x2648, 0x2653},
+	{0x265F, 0x2660},
+	{0x2663, 0x2663},
+	{0x2665, 0x2666},
+	{0x2668, 0x2668},
+	{0x267B, 0x267B},
+	{0x267E, 0x267F},
+	{0x2692, 0x2697},
+	{0x2699, 0x2699},
+	{0x269B, 0x269C},
+	{0x26A0, 0x26A1},
+	{0x26A7, 0x26A7},
+	{0x26AA, 0x26AB},
+	{0x26B0, 0x26B1},
+	{0x26BD, 0x26BE},
+	{0x26C4, 0x26C5},
+	{0x26C8, 0x26C8},
+	{0x26CE, 0x26CF},
+	{0x26D1, 0x26D1},
+	{0x26D3, 0x26D4},
+	{0x26E9, 0x26EA},
+	{0x26F0, 0x26F5},
+	{0x26F7, 0x26FA},
+	{0x26FD, 0x26FD},
+	{0x2702, 0x2702},
+	{0x2705, 0x2705},
+	{0x2708, 0x270D},
+	{0x270F, 0x270F},
+	{0x2712, 0x2712},
+	{0x2714, 0x2714},
+	{0x2716, 0x2716},
+	{0x271D, 0x271D},
+	{0x2721, 0x2721},
+	{0x2728, 0x2728},
+	{0x2733, 0x2734},
+	{0x2744, 0x2744},
+	{0x2747, 0x2747},
+	{0x274C, 0x274C},
+	{0x274E, 0x274E},
+	{0x2753, 0x2755},
+	{0x2757, 0x2757},
+	{0x2763, 0x2764},
+	{0x2795, 0x2797},
+	{0x27A1, 0x27A1},
+	{0x27B0, 0x27B0},
+	{0x27BF, 0x27BF},
+	{0x2934, 0x2935},
+	{0x2B05, 0x2B07},
+	{0x2B1B, 0x2B1C},
+	{0x2B50, 0x2B50},
+	{0x2B55, 0x2B55},
+	{0x3030, 0x3030},
+	{0x303D, 0x303D},
+	{0x3297, 0x3297},
+	{0x3299, 0x3299},
+	{0x1F004, 0x1F004},
+	{0x1F02C, 0x1F02F},
+	{0x1F094, 0x1F09F},
+	{0x1F0AF, 0x1F0B0},
+	{0x1F0C0, 0x1F0C0},
+	{0x1F0CF, 0x1F0D0},
+	{0x1F0F6, 0x1F0FF},
+	{0x1F170, 0x1F171},
+	{0x1F17E, 0x1F17F},
+	{0x1F18E, 0x1F18E},
+	{0x1F191, 0x1F19A},
+	{0x1F1AE, 0x1F1E5},
+	{0x1F201, 0x1F20F},
+	{0x1F21A, 0x1F21A},
+	{0x1F22F, 0x1F22F},
+	{0x1F232, 0x1F23A},
+	{0x1F23C, 0x1F23F},
+	{0x1F249, 0x1F25F},
+	{0x1F266, 0x1F321},
+	{0x1F324, 0x1F393},
+	{0x1F396, 0x1F397},
+	{0x1F399, 0x1F39B},
+	{0x1F39E, 0x1F3F0},
+	{0x1F3F3, 0x1F3F5},
+	{0x1F3F7, 0x1F3FA},
+	{0x1F400, 0x1F4FD},
+	{0x1F4FF, 0x1F53D},
+	{0x1F549, 0x1F54E},
+	{0x1F550, 0x1F567},
+	{0x1F56F, 0x1F570},
+	{0x1F573, 0x1F57A},
+	{0x1F587, 0x1F587},
+	{0x1F58A, 0x1F58D},
+	{0x1F590, 0x1F590},
+	{0x1F595, 0x1F596},
+	{0x1F5A4, 0x1F5A5},
+	{0x1F5A8, 0x1F5A8},
+	{0x1F5B1, 0x1F5B2},
+	{0x1F5BC, 0x1F5BC},
+	{0x1F5C2, 0x1F5C4},
+	{0x1F5D1, 0x1F5D3},
+	{0x1F5DC, 0x1F5DE},
+	{0x1F5E1, 0x1F5E1},
+	{0x1F5E3, 0x1F5E3},
+	{0x1F5E8, 0x1F5E8},
+	{0x1F5EF, 0x1F5EF},
+	{0x1F5F3, 0x1F5F3},
+	{0x1F5FA, 0x1F64F},
+	{0x1F680, 0x1F6C5},
+	{0x1F6CB, 0x1F6D2},
+	{0x1F6D5, 0x1F6E5},
+	{0x1F6E9, 0x1F6E9},
+	{0x1F6EB, 0x1F6F0},
+	{0x1F6F3, 0x1F6FF},
+	{0x1F7DA, 0x1F7FF},
+	{0x1F80C, 0x1F80F},
+	{0x1F848, 0x1F84F},
+	{0x1F85A, 0x1F85F},
+	{0x1F888, 0x1F88F},
+	{0x1F8AE, 0x1F8AF},
+	{0x1F8BC, 0x1F8BF},
+	{0x1F8C2, 0x1F8CF},
+	{0x1F8D9, 0x1F8FF},
+	{0x1F90C, 0x1F93A},
+	{0x1F93C, 0x1F945},
+	{0x1F947, 0x1F9FF},
+	{0x1FA58, 0x1FA5F},
+	{0x1FA6E, 0x1FAFF},
+	{0x1FC00, 0x1FFFD},
+}
+
+// incbTable is the table of the Indic_Conjunct_Break properties, which is
+// sorted by code points. The runes not in this table are of the property None.
+var incbTable = []incbRange{
+	{0x0300, 0x036F, incb_Extend},
+	{0x0483, 0x0489, incb_Extend},
+	{0x0591, 0x05BD, incb_Extend},
+	{0x05BF, 0x05BF, incb_Extend},
+	{0x05C1, 0x05C2, incb_Extend},
+	{0x05C4, 0x05C5, incb_Extend},
+	{0x05C7, 0x05C7, incb_Extend},
+	{0x0610, 0x061A, incb_Extend},
+	{0x064B, 0x065F, incb_Extend},
+	{0x0670, 0x0670, incb_Extend},
+	{0x06D6, 0x06DC, incb_Extend},
+	{0x06DF, 0x06E4, incb_Extend},
+	{0x06E7, 0x06E8, incb_Extend},
+	{0x06EA, 0x06ED, incb_Extend},
+	{0x0711, 0x0711, incb_Extend},
+	{0x0730, 0x074A, incb_Extend},
+	{0x07A6, 0x07B0, incb_Extend},
+	{0x07EB, 0x07F3, incb_Extend},
+	{0x07FD, 0x07FD, incb_Extend},
+	{0x0816, 0x0819, incb_Extend},
+	{0x081B, 0x0823, incb_Extend},
+	{0x0825, 0x0827, incb_Extend},
+	{0x0829, 0x082D, incb_Extend},
+	{0x0859, 0x085B, incb_Extend},
+	{0x0897, 0x089F, incb_Extend},
+	{0x08CA, 0x08E1, incb_Extend},
+	{0x08E3, 0x0902, incb_Extend},
+	{0x0915, 0x0939, incb_Consonant},
+	{0x093A, 0x093A, incb_Extend},
+	{0x093C, 0x093C, incb_Extend},
+	{0x0941, 0x0948, incb_Extend},
+	{0x094D, 0x094D, incb_Linker},
+	{0x0951, 0x0957, incb_Extend},
+	{0x0958, 0x095F, incb_Consonant},
+	{0x0962, 0x0963, incb_Extend},
+	{0x0978, 0x097F, incb_Consonant},
+	{0x0981, 0x0981, incb_Extend},
+	{0x0995, 0x09A8, incb_Consonant},
+	{0x09AA, 0x09B0, incb_Consonant},
+	{0x09B2, 0x09B2, incb_Consonant},
+	{0x09B6, 0x09B9, incb_Consonant},
+	{0x09BC, 0x09BC, incb_Extend},
+	{0x09BE, 0x09BE, incb_Extend},
+	{0x09C1, 0x09C4, incb_Extend},
+	{0x09CD, 0x09CD, incb_Linker},
+	{0x09D7, 0x09D7, incb_Extend},
+	{0x09DC, 0x09DD, incb_Consonant},
+	{0x09DF, 0x09DF, incb_Consonant},
+	{0x09E2, 0x09E3, incb_Extend},
+	{0x09F0, 0x09F1, incb_Consonant},
+	{0x09FE, 0x09FE, incb_Extend},
+	{0x0A01, 0x0A02, incb_Extend},
+	{0x0A3C, 0x0A3C, incb_Extend},
+	{0x0A41, 0x0A42, incb_Extend},
+	{0x0A47, 0x0A48, incb_Extend},
+	{0x0A4B, 0x0A4D, incb_Extend},
+	{0x0A51, 0x0A51, incb_Extend},
+	{0x0A70, 0x0A71, incb_Extend},
+	{0x0A75, 0x0A75, incb_Extend},
+	{0x0A81, 0x0A82, incb_Extend},
+	{0x0A95, 0x0AA8, incb_Consonant},
+	{0x0AAA, 0x0AB0, incb_Consonant},
+	{0x0AB2, 0x0AB3, incb_Consonant},
+	{0x0AB5, 0x0AB9, incb_Consonant},
+	{0x0ABC, 0x0ABC, incb_Extend},
+	{0x0AC1, 0x0AC5, incb_Extend},
+	{0x0AC7, 0x0AC8, incb_Extend},
+	{0x0ACD, 0x0ACD, incb_Linker},
+	{0x0AE2, 0x0AE3, incb_Extend},
+	{0x0AF9, 0x0AF9, incb_Consonant},
+	{0x0AFA, 0x0AFF, incb_Extend},
+	{0x0B01, 0x0B01, incb_Extend},
+	{0x0B15, 0x0B28, incb_Consonant},
+	{0x0B2A, 0x0B30, incb_Consonant},
+	{0x0B32, 0x0B33, incb_Consonant},
+	{0x0B35, 0x0B39, incb_Consonant},
+	{0x0B3C, 0x0B3C, incb_Extend},
+	{0x0B3E, 0x0B3F, incb_Extend},
+	{0x0B41, 0x0B44, incb_Extend},
+	{0x0B4D, 0x0B4D, incb_Linker},
+	{0x0B55, 0x0B57, incb_Extend},
+	{0x0B5C, 0x0B5D, incb_Consonant},
+	{0x0B5F, 0x0B5F, incb_Consonant},
+	{0x0B62, 0x0B63, incb_Extend},
+	{0x0B71, 0x0B71, incb_Consonant},
+	{0x0B82, 0x0B82, incb_Extend},
+	{0x0BBE, 0x0BBE, incb_Extend},
+	{0x0BC0, 0x0BC0, incb_Extend},
+	{0x0BCD, 0x0BCD, incb_Extend},
+	{0x0BD7, 0x0BD7, incb_Extend},
+	{0x0C00, 0x0C00, incb_Extend},
+	{0x0C04, 0x0C04, incb_Extend},
+	{0x0C15, 0x0C28, incb_Consonant},
+	{0x0C2A, 0x0C39, incb_Consonant},
+	{0x0C3C, 0x0C3C, incb_Extend},
+	{0x0C3E, 0x0C40, incb_Extend},
+	{0x0C46, 0x0C48, incb_Extend},
+	{0x0C4A, 0x0C4C, incb_Extend},
+	{0x0C4D, 0x0C4D, incb_Linker},
+	{0x0C55, 0x0C56, incb_Extend},
+	{0x0C58, 0x0C5A, incb_Consonant},
+	{0x0C62, 0x0C63, incb_Extend},
+	{0x0C81, 0x0C81, incb_Extend},
+	{0x0CBC, 0x0CBC, incb_Extend},
+	{0x0CBF, 0x0CC0, incb_Extend},
+	{0x0CC2, 0x0CC2, incb_Extend},
+	{0x0CC6, 0x0CC8, incb_Extend},
+	{0x0CCA, 0x0CCD, incb_Extend},
+	{0x0CD5, 0x0CD6, incb_Extend},
+	{0x0CE2, 0x0CE3, incb_Extend},
+	{0x0D00, 0x0D01, incb_Extend},
+	{0x0D15, 0x0D3A, incb_Consonant},
+	{0x0D3B, 0x0D3C, incb_Extend},
+	{0x0D3E, 0x0D3E, incb_Extend},
+	{0x0D41, 0x0D44, incb_Extend},
+	{0x0D4D, 0x0D4D, incb_Linker},
+	{0x0D57, 0x0D57, incb_Extend},
+	{0x0D62, 0x0D63, incb_Extend},
+	{0x0D81, 0x0D81, incb_Extend},
+	{0x0DCA, 0x0DCA, incb_Extend},
+	{0x0DCF, 0x0DCF, incb_Extend},
+	{0x0DD2, 0x0DD4, incb_Extend},
+	{0x0DD6, 0x0DD6, incb_Extend},
+	{0x0DDF, 0x0DDF, incb_Extend},
+	{0x0E31, 0x0E31, incb_Extend},
+	{0x0E34, 0x0E3A, incb_Extend},
+	{0x0E47, 0x0E4E, incb_Extend},
+	{0x0EB1, 0x0EB1, incb_Extend},
+	{0x0EB4, 0x0EBC, incb_Extend},
+	{0x0EC8, 0x0ECE, incb_Extend},
+	{0x0F18, 0x0F19, incb_Extend},
+	{0x0F35, 0x0F35, incb_Extend},
+	{0x0F37, 0x0F37, incb_Extend},
+	{0x0F39, 0x0F39, incb_Extend},
+	{0x0F71, 0x0F7E, incb_Extend},
+	{0x0F80, 0x0F84, incb_Extend},
+	{0x0F86, 0x0F87, incb_Extend},
+	{0x0F8D, 0x0F97, incb_Extend},
+	{0x0F99, 0x0FBC, incb_Extend},
+	{0x0FC6, 0x0FC6, incb_Extend},
+	{0x1000, 0x102A, incb_Consonant},
+	{0x102D, 0x1030, incb_Extend},
+	{0x1032, 0x1037, incb_Extend},
+	{0x1039, 0x1039, incb_Linker},
+	{0x103A, 0x103A, incb_Extend},
+	{0x103D, 0x103E, incb_Extend},
+	{0x103F, 0x103F, incb_Consonant},
+	{0x1050, 0x1055, incb_Consonant},
+	{0x1058, 0x1059, incb_Extend},
+	{0x105A, 0x105D, incb_Consonant},
+	{0x105E, 0x1060, incb_Extend},
+	{0x1061, 0x1061, incb_Consonant},
+	{0x1065, 0x1066, incb_Consonant},
+	{0x106E, 0x1070, incb_Consonant},
+	{0x1071, 0x1074, incb_Extend},
+	{0x1075, 0x1081, incb_Consonant},
+	{0x1082, 0x1082, incb_Extend},
+	{0x1085, 0x1086, incb_Extend},
+	{0x108D, 0x108D, incb_Extend},
+	{0x108E, 0x108E, incb_Consonant},
+	{0x109D, 0x109D, incb_Extend},
+	{0x135D, 0x135F, incb_Extend},
+	{0x1712, 0x1715, incb_Extend},
+	{0x1732, 0x1734, incb_Extend},
+	{0x1752, 0x1753, incb_Extend},
+	{0x1772, 0x1773, incb_Extend},
+	{0x1780, 0x17B3, incb_Consonant},
+	{0x17B4, 0x17B5, incb_Extend},
+	{0x17B7, 0x17BD, incb_Extend},
+	{0x17C6, 0x17C6, incb_Extend},
+	{0x17C9, 0x17D1, incb_Extend},
+	{0x17D2, 0x17D2, incb_Linker},
+	{0x17D3, 0x17D3, incb_Extend},
+	{0x17DD, 0x17DD, incb_Extend},
+	{0x180B, 0x180D, incb_Extend},
+	{0x180F, 0x180F, incb_Extend},
+	{0x1885, 0x1886, incb_Extend},
+	{0x18A9, 0x18A9, incb_Extend},
+	{0x1920, 0x1922, incb_Extend},
+	{0x1927, 0x1928, incb_Extend},
+	{0x1932, 0x1932, incb_Extend},
+	{0x1939, 0x193B, incb_Extend},
+	{0x1A17, 0x1A18, incb_Extend},
+	{0x1A1B, 0x1A1B, incb_Extend},
+	{0x1A20, 0x1A54, incb_Consonant},
+	{0x1A56, 0x1A56, incb_Extend},
+	{0x1A58, 0x1A5E, incb_Extend},
+	{0x1A60, 0x1A60, incb_Linker},
+	{0x1A62, 0x1A62, incb_Extend},
+	{0x1A65, 0x1A6C, incb_Extend},
+	{0x1A73, 0x1A7C, incb_Extend},
+	{0x1A7F, 0x1A7F, incb_Extend},
+	{0x1AB0, 0x1ADD, incb_Extend},
+	{0x1AE0, 0x1AEB, incb_Extend},
+	{0x1B00, 0x1B03, incb_Extend},
+	{0x1B0B, 0x1B0C, incb_Consonant},
+	{0x1B13, 0x1B33, incb_Consonant},
+	{0x1B34, 0x1B3D, incb_Extend},
+	{0x1B42, 0x1B43, incb_Extend},
+	{0x1B44, 0x1B44, incb_Linker},
+	{0x1B45, 0x1B4C, incb_Consonant},
+	{0x1B6B, 0x1B73, incb_Extend},
+	{0x1B80, 0x1B81, incb_Extend},
+	{0x1B83, 0x1BA0, incb_Consonant},
+	{0x1BA2, 0x1BA5, incb_Extend},
+	{0x1BA8, 0x1BAA, incb_Extend},
+	{0x1BAB, 0x1BAB, incb_Linker},
+	{0x1BAC, 0x1BAD, incb_Extend},
+	{0x1BAE, 0x1BAF, incb_Consonant},
+	{0x1BBB, 0x1BBD, incb_Consonant},
+	{0x1BE6, 0x1BE6, incb_Extend},
+	{0x1BE8, 0x1BE9, incb_Extend},
+	{0x1BED, 0x1BED, incb_Extend},
+	{0x1BEF, 0x1BF3, incb_Extend},
+	{0x1C2C, 0x1C33, incb_Extend},
+	{0x1C36, 0x1C37, incb_Extend},
+	{0x1CD0, 0x1CD2, incb_Extend},
+	{0x1CD4, 0x1CE0, incb_Extend},
+	{0x1CE2, 0x1CE8, incb_Extend},
+	{0x1CED, 0x1CED, incb_Extend},
+	{0x1CF4, 0x1CF4, incb_Extend},
+	{0x1CF8, 0x1CF9, incb_Extend},
+	{0x1DC0, 0x1DFF, incb_Extend},
+	{0x200D, 0x200D, incb_Extend},
+	{0x20D0, 0x20F0, incb_Extend},
+	{0x2CEF, 0x2CF1, incb_Extend},
+	{0x2D7F, 0x2D7F, incb_Extend},
+	{0x2DE0, 0x2DFF, incb_Extend},
+	{0x302A, 0x302F, incb_Extend},
+	{0x3099, 0x309A, incb_Extend},
+	{0xA66F, 0xA672, incb_Extend},
+	{0xA674, 0xA67D, incb_Extend},
+	{0xA69E, 0xA69F, incb_Extend},
+	{0xA6F0, 0xA6F1, incb_Extend},
+	{0xA802, 0xA802, incb_Extend},
+	{0xA806, 0xA806, incb_Extend},
+	{0xA80B, 0xA80B, incb_Extend},
+	{0xA825, 0xA826, incb_Extend},
+	{0xA82C, 0xA82C, incb_Extend},
+	{0xA8C4, 0xA8C5, incb_Extend},
+	{0xA8E0, 0xA8F1, incb_Extend},
+	{0xA8FF, 0xA8FF, incb_Extend},
+	{0xA926, 0xA92D, incb_Extend},
+	{0xA947, 0xA951, incb_Extend},
+	{0xA953, 0xA953, incb_Extend},
+	{0xA980, 0xA982, incb_Extend},
+	{0xA989, 0xA98B, incb_Consonant},
+	{0xA98F, 0xA9B2, incb_Consonant},
+	{0xA9B3, 0xA9B3, incb_Extend},
+	{0xA9B6, 0xA9B9, incb_Extend},
+	{0xA9BC, 0xA9BD, incb_Extend},
+	{0xA9C0, 0xA9C0, incb_Linker},
+	{0xA9E0, 0xA9E4, incb_Consonant},
+	{0xA9E5, 0xA9E5, incb_Extend},
+	{0xA9E7, 0xA9EF, incb_Consonant},
+	{0xA9FA, 0xA9FE, incb_Consonant},
+	{0xAA29, 0xAA2E, incb_Extend},
+	{0xAA31, 0xAA32, incb_Extend},
+	{0xAA35, 0xAA36, incb_Extend},
+	{0xAA43, 0xAA43, incb_Extend},
+	{0xAA4C, 0xAA4C, incb_Extend},
+	{0xAA60, 0xAA6F, incb_Consonant},
+	{0xAA71, 0xAA73, incb_Consonant},
+	{0xAA7A, 0xAA7A, incb_Consonant},
+	{0xAA7C, 0xAA7C, incb_Extend},
+	{0xAA7E, 0xAA7F, incb_Consonant},
+	{0xAAB0, 0xAAB0, incb_Extend},
+	{0xAAB2, 0xAAB4, incb_Extend},
+	{0xAAB7, 0xAAB8, incb_Extend},
+	{0xAABE, 0xAABF, incb_Extend},
+	{0xAAC1, 0xAAC1, incb_Extend},
+	{0xAAE0, 0xAAEA, incb_Consonant},
+	{0xAAEC, 0xAAED, incb_Extend},
+	{0xAAF6, 0xAAF6, incb_Linker},
+	{0xABC0, 0xABDA, incb_Consonant},
+	{0xABE5, 0xABE5, incb_Extend},
+	{0xABE8, 0xABE8, incb_Extend},
+	{0xABED, 0xABED, incb_Extend},
+	{0xFB1E, 0xFB1E, incb_Extend},
+	{0xFE00, 0xFE0F, incb_Extend},
+	{0xFE20, 0xFE2F, incb_Extend},
+	{0xFF9E, 0xFF9F, incb_Extend},
+	{0x101FD, 0x101FD, incb_Extend},
+	{0x102E0, 0x102E0, incb_Extend},
+	{0x10376, 0x1037A, incb_Extend},
+	{0x10A00, 0x10A00, incb_Consonant},
+	{0x10A01, 0x10A03, incb_Extend},
+	{0x10A05, 0x10A06, incb_Extend},
+	{0x10A0C, 0x10A0F, incb_Extend},
+	{0x10A10, 0x10A13, incb_Consonant},
+	{0x10A15, 0x10A17, incb_Consonant},
+	{0x10A19, 0x10A35, incb_Consonant},
+	{0x10A38, 0x10A3A, incb_Extend},
+	{0x10A3F, 0x10A3F, incb_Linker},
+	{0x10AE5, 0x10AE6, incb_Extend},
+	{0x10D24, 0x10D27, incb_Extend},
+	{0x10D69, 0x10D6D, incb_Extend},
+	{0x10EAB, 0x10EAC, incb_Extend},
+	{0x10EFA, 0x10EFF, incb_Extend},
+	{0x10F46, 0x10F50, incb_Extend},
+	{0x10F82, 0x10F85, incb_Extend},
+	{0x11001, 0x11001, incb_Extend},
+	{0x11038, 0x11046, incb_Extend},
+	{0x11070, 0x11070, incb_Extend},
+	{0x11073, 0x11074, incb_Extend},
+	{0x1107F, 0x11081, incb_Extend},
+	{0x110B3, 0x110B6, incb_Extend},
+	{0x110B9, 0x110BA, incb_Extend},
+	{0x110C2, 0x110C2, incb_Extend},
+	{0x11100, 0x11102, incb_Extend},
+	{0x11103, 0x11126, incb_Consonant},
+	{0x11127, 0x1112B, incb_Extend},
+	{0x1112D, 0x11132, incb_Extend},
+	{0x11133, 0x11133, incb_Linker},
+	{0x11134, 0x11134, incb_Extend},
+	{0x11144, 0x11144, incb_Consonant},
+	{0x11147, 0x11147, incb_Consonant},
+	{0x11173, 0x11173, incb_Extend},
+	{0x11180, 0x11181, incb_Extend},
+	{0x111B6, 0x111BE, incb_Extend},
+	{0x111C0, 0x111C0, incb_Extend},
+	{0x111C9, 0x111CC, incb_Extend},
+	{0x111CF, 0x111CF, incb_Extend},
+	{0x1122F, 0x11231, incb_Extend},
+	{0x11234, 0x11237, incb_Extend},
+	{0x1123E, 0x1123E, incb_Extend},
+	{0x11241, 0x11241, incb_Extend},
+	{0x112DF, 0x112DF, incb_Extend},
+	{0x112E3, 0x112EA, incb_Extend},
+	{0x11300, 0x11301, incb_Extend},
+	{0x1133B, 0x1133C, incb_Extend},
+	{0x1133E, 0x1133E, incb_Extend},
+	{0x11340, 0x11340, incb_Extend},
+	{0x1134D, 0x1134D, incb_Extend},
+	{0x11357, 0x11357, incb_Extend},
+	{0x11366, 0x1136C, incb_Extend},
+	{0x11370, 0x11374, incb_Extend},
+	{0x11380, 0x11389, incb_Consonant},
+	{0x1138B, 0x1138B, incb_Consonant},
+	{0x1138E, 0x1138E, incb_Consonant},
+	{0x11390, 0x113B5, incb_Consonant},
+	{0x113B8, 0x113B8, incb_Extend},
+	{0x113BB, 0x113C0, incb_Extend},
+	{0x113C2, 0x113C2, incb_Extend},
+	{0x113C5, 0x113C5, incb_Extend},
+	{0x113C7, 0x113C9, incb_Extend},
+	{0x113CE, 0x113CF, incb_Extend},
+	{0x113D0, 0x113D0, incb_Linker},
+	{0x113D2, 0x113D2, incb_Extend},
+	{0x113E1, 0x113E2, incb_Extend},
+	{0x11438, 0x1143F, incb_Extend},
+	{0x11442, 0x11444, incb_Extend},
+	{0x11446, 0x11446, incb_Extend},
+	{0x1145E, 0x1145E, incb_Extend},
+	{0x114B0, 0x114B0, incb_Extend},
+	{0x114B3, 0x114B8, incb_Extend},
+	{0x114BA, 0x114BA, incb_Extend},
+	{0x114BD, 0x114BD, incb_Extend},
+	{0x114BF, 0x114C0, incb_Extend},
+	{0x114C2, 0x114C3, incb_Extend},
+	{0x115AF, 0x115AF, incb_Extend},
+	{0x115B2, 0x115B5, incb_Extend},
+	{0x115BC, 0x115BD, incb_Extend},
+	{0x115BF, 0x115C0, incb_Extend},
+	{0x115DC, 0x115DD, incb_Extend},
+	{0x11633, 0x1163A, incb_Extend},
+	{0x1163D, 0x1163D, incb_Extend},
+	{0x1163F, 0x11640, incb_Extend},
+	{0x116AB, 0x116AB, incb_Extend},
+	{0x116AD, 0x116AD, incb_Extend},
+	{0x116B0, 0x116B7, incb_Extend},
+	{0x1171D, 0x1171D, incb_Extend},
+	{0x1171F, 0x1171F, incb_Extend},
+	{0x11722, 0x11725, incb_Extend},
+	{0x11727, 0x1172B, incb_Extend},
+	{0x1182F, 0x11837, incb_Extend},
+	{0x11839, 0x1183A, incb_Extend},
+	{0x11900, 0x11906, incb_Consonant},
+	{0x11909, 0x11909, incb_Consonant},
+	{0x1190C, 0x11913, incb_Consonant},
+	{0x11915, 0x11916, incb_Consonant},
+	{0x11918, 0x1192F, incb_Consonant},
+	{0x11930, 0x11930, incb_Extend},
+	{0x1193B, 0x1193D, incb_Extend},
+	{0x1193E, 0x1193E, incb_Linker},
+	{0x11943, 0x11943, incb_Extend},
+	{0x119D4, 0x119D7, incb_Extend},
+	{0x119DA, 0x119DB, incb_Extend},
+	{0x119E0, 0x119E0, incb_Extend},
+	{0x11A00, 0x11A00, incb_Consonant},
+	{0x11A01, 0x11A0A, incb_Extend},
+	{0x11A0B, 0x11A32, incb_Consonant},
+	{0x11A33, 0x11A38, incb_Extend},
+	{0x11A3B, 0x11A3E, incb_Extend},
+	{0x11A47, 0x11A47, incb_Linker},
+	{0x11A50, 0x11A50, incb_Consonant},
+	{0x11A51, 0x11A56, incb_Extend},
+	{0x11A59, 0x11A5B, incb_Extend},
+	{0x11A5C, 0x11A83, incb_Consonant},
+	{0x11A8A, 0x11A96, incb_Extend},
+	{0x11A98, 0x11A98, incb_Extend},
+	{0x11A99, 0x11A99, incb_Linker},
+	{0x11B60, 0x11B60, incb_Extend},
+	{0x11B62, 0x11B64, incb_Extend},
+	{0x11B66, 0x11B66, incb_Extend},
+	{0x11C30, 0x11C36, incb_Extend},
+	{0x11C38, 0x11C3D, incb_Extend},
+	{0x11C3F, 0x11C3F, incb_Extend},
+	{0x11C92, 0x11CA7, incb_Extend},
+	{0x11CAA, 0x11CB0, incb_Extend},
+	{0x11CB2, 0x11CB3, incb_Extend},
+	{0x11CB5, 0x11CB6, incb_Extend},
+	{0x11D31, 0x11D36, incb_Extend},
+	{0x11D3A, 0x11D3A, incb_Extend},
+	{0x11D3C, 0x11D3D, incb_Extend},
+	{0x11D3F, 0x11D45, incb_Extend},
+	{0x11D47, 0x11D47, incb_Extend},
+	{0x11D90, 0x11D91, incb_Extend},
+	{0x11D95, 0x11D95, incb_Extend},
+	{0x11D97, 0x11D97, incb_Extend},
+	{0x11EF3, 0x11EF4, incb_Extend},
+	{0x11F00, 0x11F01, incb_Extend},
+	{0x11F04, 0x11F10, incb_Consonant},
+	{0x11F12, 0x11F33, incb_Consonant},
+	{0x11F36, 0x11F3A, incb_Extend},
+	{0x11F40, 0x11F41, incb_Extend},
+	{0x11F42, 0x11F42, incb_Linker},
+	{0x11F5A, 0x11F5A, incb_Extend},
+	{0x13440, 0x13440, incb_Extend},
+	{0x13447, 0x13455, incb_Extend},
+	{0x1611E, 0x16129, incb_Extend},
+	{0x1612D, 0x1612F, incb_Extend},
+	{0x16AF0, 0x16AF4, incb_Extend},
+	{0x16B30, 0x16B36, incb_Extend},
+	{0x16F4F, 0x16F4F, incb_Extend},
+	{0x16F8F, 0x16F92, incb_Extend},
+	{0x16FE4, 0x16FE4, incb_Extend},
+	{0x16FF0, 0x16FF1, incb_Extend},
+	{0x1BC9D, 0x1BC9E, incb_Extend},
+	{0x1CF00, 0x1CF2D, incb_Extend},
+	{0x1CF30, 0x1CF46, incb_Extend},
+	{0x1D165, 0x1D169, incb_Extend},
+	{0x1D16D, 0x1D172, incb_Extend},
+	{0x1D17B, 0x1D182, incb_Extend},
+	{0x1D185, 0x1D18B, incb_Extend},
+	{0x1D1AA, 0x1D1AD, incb_Extend},
+	{0x1D242, 0x1D244, incb_Extend},
+	{0x1DA00, 0x1DA36, incb_Extend},
+	{0x1DA3B, 0x1DA6C, incb_Extend},
+	{0x1DA75, 0x1DA75, incb_Extend},
+	{0x1DA84, 0x1DA84, incb_Extend},
+	{0x1DA9B, 0x1DA9F, incb_Extend},
+	{0x1DAA1, 0x1DAAF, incb_Extend},
+	{0x1E000, 0x1E006, incb_Extend},
+	{0x1E008, 0x1E018, incb_Extend},
+	{0x1E01B, 0x1E021, incb_Extend},
+	{0x1E023, 0x1E024, incb_Extend},
+	{0x1E026, 0x1E02A, incb_Extend},
+	{0x1E08F, 0x1E08F, incb_Extend},
+	{0x1E130, 0x1E136, incb_Extend},
+	{0x1E2AE, 0x1E2AE, incb_Extend},
+	{0x1E2EC, 0x1E2EF, incb_Extend},
+	{0x1E4EC, 0x1E4EF, incb_Extend},
+	{0x1E5EE, 0x1E5EF, incb_Extend},
+	{0x1E6E3, 0x1E6E3, incb_Extend},
+	{0x1E6E6, 0x1E6E6, incb_Extend},
+	{0x1E6EE, 0x1E6EF, incb_Extend},
+	{0x1E6F5, 0x1E6F5, incb_Extend},
+	{0x1E8D0, 0x1E8D6, incb_Extend},
+	{0x1E944, 0x1E94A, incb_Extend},
+	{0x1F3FB, 0x1F3FF, incb_Extend},
+	{0xE0020, 0xE007F, incb_Extend},
+	{0xE0100, 0xE01EF, incb_Extend},
+}
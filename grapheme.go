@@ -0,0 +1,200 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+// Grapheme cluster break property of Unicode Standard Annex #29 (UAX29).
+type gcbProp uint8
+
+const (
+	gcb_Other gcbProp = iota
+	gcb_CR
+	gcb_LF
+	gcb_Control
+	gcb_Extend
+	gcb_ZWJ
+	gcb_RI
+	gcb_Prepend
+	gcb_SpacingMark
+	gcb_L
+	gcb_V
+	gcb_T
+	gcb_LV
+	gcb_LVT
+)
+
+type gcbRange struct {
+	first rune
+	last  rune
+	prop  gcbProp
+}
+
+// Indic_Conjunct_Break property of Unicode, which is used by GB9c of UAX29.
+type incbProp uint8
+
+const (
+	incb_None incbProp = iota
+	incb_Linker
+	incb_Consonant
+	incb_Extend
+)
+
+type incbRange struct {
+	first rune
+	last  rune
+	prop  incbProp
+}
+
+// graphemeProperty is the function that returns the grapheme cluster break
+// property of the specified rune, which is looked up in gcbTable.
+func graphemeProperty(r rune) gcbProp {
+	lo, hi := 0, len(gcbTable)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		rng := gcbTable[m]
+		if r < rng.first {
+			hi = m
+		} else if r > rng.last {
+			lo = m + 1
+		} else {
+			return rng.prop
+		}
+	}
+	return gcb_Other
+}
+
+// indicConjunctBreak is the function that returns the Indic_Conjunct_Break
+// property of the specified rune, which is looked up in incbTable.
+func indicConjunctBreak(r rune) incbProp {
+	lo, hi := 0, len(incbTable)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		rng := incbTable[m]
+		if r < rng.first {
+			hi = m
+		} else if r > rng.last {
+			lo = m + 1
+		} else {
+			return rng.prop
+		}
+	}
+	return incb_None
+}
+
+// graphemeState is the struct that finds extended grapheme cluster boundaries
+// by the rules (GB1 - GB999) of Unicode Standard Annex #29 (UAX29).
+// This struct receives runes one by one and returns whether there is a
+// boundary between the previous rune and the received rune.
+type graphemeState struct {
+	started  bool
+	prev     gcbProp
+	riOdd    bool // whether the count of the preceding RIs is odd
+	extPict  bool // whether the preceding runes match ExtPict Extend*
+	emojiZWJ bool // whether the preceding runes match ExtPict Extend* ZWJ
+	conso    bool // whether the preceding runes match Consonant [Extend Linker]*
+	linker   bool // whether the runes after the Consonant include Linker
+}
+
+func (s *graphemeState) reset() {
+	*s = graphemeState{}
+}
+
+// next is the method that returns true if there is a grapheme cluster
+// boundary between the previous rune and the specified rune.
+func (s *graphemeState) next(r rune) bool {
+	c := graphemeProperty(r)
+	ic := indicConjunctBreak(r)
+	isBoundary := s.decide(r, c, ic)
+
+	p := s.prev
+	s.riOdd = (c == gcb_RI && !(p == gcb_RI && s.riOdd))
+	s.emojiZWJ = (c == gcb_ZWJ && s.extPict)
+	if isExtendedPictographic(r) {
+		s.extPict = true
+	} else if c != gcb_Extend {
+		s.extPict = false
+	}
+	switch {
+	case ic == incb_Consonant:
+		s.conso, s.linker = true, false
+	case ic == incb_Linker && s.conso:
+		s.linker = true
+	case ic == incb_Extend && s.conso:
+	default:
+		s.conso, s.linker = false, false
+	}
+	s.prev = c
+	s.started = true
+	return isBoundary
+}
+
+func (s *graphemeState) decide(r rune, c gcbProp, ic incbProp) bool {
+	if !s.started { // GB1
+		return true
+	}
+
+	p := s.prev
+
+	// GB3
+	if p == gcb_CR && c == gcb_LF {
+		return false
+	}
+
+	// GB4, GB5
+	switch p {
+	case gcb_CR, gcb_LF, gcb_Control:
+		return true
+	}
+	switch c {
+	case gcb_CR, gcb_LF, gcb_Control:
+		return true
+	}
+
+	// GB6, GB7, GB8
+	switch p {
+	case gcb_L:
+		switch c {
+		case gcb_L, gcb_V, gcb_LV, gcb_LVT:
+			return false
+		}
+	case gcb_LV, gcb_V:
+		switch c {
+		case gcb_V, gcb_T:
+			return false
+		}
+	case gcb_LVT, gcb_T:
+		if c == gcb_T {
+			return false
+		}
+	}
+
+	// GB9, GB9a
+	switch c {
+	case gcb_Extend, gcb_ZWJ, gcb_SpacingMark:
+		return false
+	}
+
+	// GB9b
+	if p == gcb_Prepend {
+		return false
+	}
+
+	// GB9c
+	if s.linker && ic == incb_Consonant {
+		return false
+	}
+
+	// GB11
+	if s.emojiZWJ && isExtendedPictographic(r) {
+		return false
+	}
+
+	// GB12, GB13
+	if p == gcb_RI && c == gcb_RI && s.riOdd {
+		return false
+	}
+
+	// GB999
+	return true
+}
@@ -0,0 +1,176 @@
+package linebreak
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func graphemeClusters(text string) []string {
+	clusters := []string{}
+	var gs graphemeState
+	var cluster []rune
+	for _, r := range text {
+		if gs.next(r) && len(cluster) > 0 {
+			clusters = append(clusters, string(cluster))
+			cluster = cluster[:0]
+		}
+		cluster = append(cluster, r)
+	}
+	if len(cluster) > 0 {
+		clusters = append(clusters, string(cluster))
+	}
+	return clusters
+}
+
+func TestGraphemeState_combiningMarks(t *testing.T) {
+	assert.Equal(t, graphemeClusters("e\u0301a"), []string{"e\u0301", "a"})
+	assert.Equal(t, graphemeClusters("\u0301a"), []string{"\u0301", "a"})
+	assert.Equal(t, graphemeClusters("か\u3099"), []string{"か\u3099"})
+	assert.Equal(t, graphemeClusters("\u0915\u093f"), []string{"\u0915\u093f"})
+}
+
+func TestGraphemeState_controls(t *testing.T) {
+	assert.Equal(t, graphemeClusters("a\r\nb"), []string{"a", "\r\n", "b"})
+	assert.Equal(t, graphemeClusters("a\n\rb"), []string{"a", "\n", "\r", "b"})
+	assert.Equal(t, graphemeClusters("\n\u0301"), []string{"\n", "\u0301"})
+}
+
+func TestGraphemeState_hangul(t *testing.T) {
+	assert.Equal(t, graphemeClusters("\u1100\u1161\u11a8"),
+		[]string{"\u1100\u1161\u11a8"})
+	assert.Equal(t, graphemeClusters("한국"), []string{"한", "국"})
+	assert.Equal(t, graphemeClusters("가\u11a8"), []string{"가\u11a8"})
+}
+
+func TestGraphemeState_emoji(t *testing.T) {
+	family := "👨\u200d👩\u200d👧"
+	assert.Equal(t, graphemeClusters(family+"a"), []string{family, "a"})
+	assert.Equal(t, graphemeClusters("👍🏽👍"), []string{"👍🏽", "👍"})
+	assert.Equal(t, graphemeClusters("a\u200d👍"), []string{"a\u200d", "👍"})
+	assert.Equal(t, graphemeClusters("☺\ufe0f"), []string{"☺\ufe0f"})
+}
+
+func TestGraphemeState_regionalIndicators(t *testing.T) {
+	assert.Equal(t, graphemeClusters("🇯🇵🇺🇸🇫"), []string{"🇯🇵", "🇺🇸", "🇫"})
+}
+
+func TestGraphemeState_indicConjunct(t *testing.T) {
+	assert.Equal(t, graphemeClusters("\u0915\u094d\u0937"),
+		[]string{"\u0915\u094d\u0937"})
+	assert.Equal(t, graphemeClusters("\u0915\u094d\u200d\u0937"),
+		[]string{"\u0915\u094d\u200d\u0937"})
+	assert.Equal(t, graphemeClusters("\u0915\u0937"),
+		[]string{"\u0915", "\u0937"})
+	assert.Equal(t, graphemeClusters("a\u094d\u0937"),
+		[]string{"a\u094d", "\u0937"})
+}
+
+func testGraphemeBreakTestFile(t *testing.T, path string) {
+	f, err := os.Open(path)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if n == 1 {
+			assert.Equal(t, line, "# GraphemeBreakTest-"+ucdVersion+".txt")
+		}
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[0:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		var runes []rune
+		var expected []string
+		for i := 1; i < len(fields)-1; i += 2 {
+			c, err := strconv.ParseUint(fields[i], 16, 32)
+			assert.Nil(t, err)
+			runes = append(runes, rune(c))
+			if i > 1 {
+				expected = append(expected, fields[i-1])
+			}
+		}
+
+		var gs graphemeState
+		var actual []string
+		for i, r := range runes {
+			isBoundary := gs.next(r)
+			if i == 0 {
+				continue
+			}
+			if isBoundary {
+				actual = append(actual, "÷")
+			} else {
+				actual = append(actual, "×")
+			}
+		}
+
+		assert.Equal(t, actual, expected, "%s:%d: %s", path, n, line)
+	}
+	assert.Nil(t, sc.Err())
+}
+
+// GraphemeBreakTest.txt of the Unicode Character Database of the same version
+// as gcbTable.
+func TestGraphemeState_graphemeBreakTest(t *testing.T) {
+	testGraphemeBreakTestFile(t, "testdata/GraphemeBreakTest.txt")
+}
+
+func TestGcbTable_sorted(t *testing.T) {
+	for i := 1; i < len(gcbTable); i++ {
+		assert.True(t, gcbTable[i-1].last < gcbTable[i].first)
+		assert.True(t, gcbTable[i].first <= gcbTable[i].last)
+	}
+	for i := 1; i < len(extPictTable); i++ {
+		assert.True(t, extPictTable[i-1][1] < extPictTable[i][0])
+		assert.True(t, extPictTable[i][0] <= extPictTable[i][1])
+	}
+	for i := 1; i < len(incbTable); i++ {
+		assert.True(t, incbTable[i-1].last < incbTable[i].first)
+		assert.True(t, incbTable[i].first <= incbTable[i].last)
+	}
+}
+
+func TestClusterWidth(t *testing.T) {
+	assert.Equal(t, clusterWidth([]rune("a")), 1)
+	assert.Equal(t, clusterWidth([]rune("e\u0301")), 1)
+	assert.Equal(t, clusterWidth([]rune("か\u3099")), 2)
+	assert.Equal(t, clusterWidth([]rune("👨\u200d👩\u200d👧")), 2)
+	assert.Equal(t, clusterWidth([]rune("🇯🇵")), 2)
+	assert.Equal(t, clusterWidth([]rune("☺\ufe0f")), 2)
+	assert.Equal(t, clusterWidth([]rune("\u1100\u1161\u11a8")), 2)
+}
+
+func TestRuneBuffer_addCluster(t *testing.T) {
+	rb := newRuneBuffer(5)
+
+	assert.True(t, rb.addCluster([]rune("e\u0301"), 1))
+	assert.Equal(t, rb.full(), []rune{'e', 0x301})
+	assert.Equal(t, rb.widths[0:rb.length], []int{1, -1})
+
+	assert.True(t, rb.add('a'))
+	assert.Equal(t, rb.widths[0:rb.length], []int{1, -1, 1})
+
+	assert.False(t, rb.addCluster([]rune("🇯🇵🇺"), 2))
+	assert.Equal(t, rb.length, 3)
+
+	rb.cr(2)
+	assert.Equal(t, rb.full(), []rune{'a'})
+	assert.Equal(t, rb.widths[0:rb.length], []int{1})
+
+	rb.grow(3)
+	assert.True(t, rb.addCluster([]rune("👨\u200d👩\u200d👧"), 2))
+	assert.Equal(t, rb.length, 6)
+	assert.Equal(t, rb.widths[0:rb.length], []int{1, 2, -1, -1, -1, -1})
+}
@@ -0,0 +1,223 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const softHyphen = 0x00AD
+
+// ErrBadPattern is the error which is returned when a hyphenation pattern
+// file cannot be parsed.
+var ErrBadPattern = errors.New("linebreak: bad hyphenation pattern")
+
+// Hyphenator is the struct that finds hyphenation points of words with the
+// Knuth-Liang algorithm, which is used by TeX.
+// LeftMin and RightMin are the minimum numbers of letters before and after a
+// hyphenation point.
+type Hyphenator struct {
+	LeftMin    int
+	RightMin   int
+	patterns   map[string][]uint8
+	maxLen     int
+	exceptions map[string][]int
+}
+
+// NewHyphenator is the function that creates a Hyphenator instance with the
+// patterns read from the given io.Reader.
+// The content can be a TeX pattern file, which contains \patterns{...} and
+// optionally \hyphenation{...} for exception words, such as hyph-en-us.tex,
+// or a plain list of patterns separated by white spaces.
+// A text after % to the end of a line is a comment.
+func NewHyphenator(r io.Reader) (*Hyphenator, error) {
+	h := &Hyphenator{
+		LeftMin:    2,
+		RightMin:   3,
+		patterns:   make(map[string][]uint8),
+		exceptions: make(map[string][]int),
+	}
+
+	var sb strings.Builder
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexByte(line, '%'); i >= 0 {
+			line = line[0:i]
+		}
+		sb.WriteString(line)
+		sb.WriteByte('\n')
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	text := sb.String()
+	if !strings.Contains(text, `\patterns`) {
+		for _, pat := range strings.Fields(text) {
+			if err := h.addPattern(pat); err != nil {
+				return nil, err
+			}
+		}
+		return h, nil
+	}
+
+	for len(text) > 0 {
+		i := strings.IndexByte(text, '\\')
+		if i < 0 {
+			break
+		}
+		text = text[i+1:]
+
+		var add func(string) error
+		switch {
+		case strings.HasPrefix(text, "patterns"):
+			add = h.addPattern
+		case strings.HasPrefix(text, "hyphenation"):
+			add = h.addException
+		default:
+			continue
+		}
+
+		lb := strings.IndexByte(text, '{')
+		rb := strings.IndexByte(text, '}')
+		if lb < 0 || rb < lb {
+			return nil, ErrBadPattern
+		}
+		for _, s := range strings.Fields(text[lb+1 : rb]) {
+			if err := add(s); err != nil {
+				return nil, err
+			}
+		}
+		text = text[rb+1:]
+	}
+
+	return h, nil
+}
+
+var (
+	englishHyphenator     *Hyphenator
+	englishHyphenatorOnce sync.Once
+)
+
+// EnglishHyphenator is the function that returns a Hyphenator instance with
+// the embedded patterns for American English, which are the patterns of
+// hyphen.tex of TeX, so that words are hyphenated in the same way as TeX.
+// Each call returns a new instance, so that LeftMin and RightMin of it can be
+// changed without affecting other instances. The patterns, which are parsed
+// only once, are shared among the instances and are never changed.
+func EnglishHyphenator() *Hyphenator {
+	englishHyphenatorOnce.Do(func() {
+		h, err := NewHyphenator(strings.NewReader(englishPatterns))
+		if err != nil {
+			panic(err)
+		}
+		englishHyphenator = h
+	})
+	h := *englishHyphenator
+	return &h
+}
+
+func (h *Hyphenator) addPattern(pat string) error {
+	letters := make([]rune, 0, len(pat))
+	values := make([]uint8, 1, len(pat)+1)
+	for _, r := range pat {
+		if '0' <= r && r <= '9' {
+			if values[len(values)-1] != 0 {
+				return ErrBadPattern
+			}
+			values[len(values)-1] = uint8(r - '0')
+			continue
+		}
+		letters = append(letters, unicode.ToLower(r))
+		values = append(values, 0)
+	}
+	if len(letters) == 0 {
+		return ErrBadPattern
+	}
+
+	h.patterns[string(letters)] = values
+	if len(letters) > h.maxLen {
+		h.maxLen = len(letters)
+	}
+	return nil
+}
+
+func (h *Hyphenator) addException(word string) error {
+	letters := make([]rune, 0, len(word))
+	points := make([]int, 0, 4)
+	for _, r := range word {
+		if r == '-' {
+			points = append(points, len(letters))
+			continue
+		}
+		letters = append(letters, unicode.ToLower(r))
+	}
+	if len(letters) == 0 {
+		return ErrBadPattern
+	}
+	h.exceptions[string(letters)] = points
+	return nil
+}
+
+// Hyphenate is the method that returns the hyphenation points of the
+// specified word.
+// A hyphenation point is the number of runes before the point.
+func (h *Hyphenator) Hyphenate(word string) []int {
+	letters := []rune(strings.ToLower(word))
+	n := len(letters)
+	if n < h.LeftMin+h.RightMin {
+		return nil
+	}
+
+	if points, ok := h.exceptions[string(letters)]; ok {
+		result := make([]int, 0, len(points))
+		for _, p := range points {
+			if h.LeftMin <= p && p <= n-h.RightMin {
+				result = append(result, p)
+			}
+		}
+		return result
+	}
+
+	w := make([]rune, 0, n+2)
+	w = append(w, '.')
+	w = append(w, letters...)
+	w = append(w, '.')
+
+	values := make([]uint8, len(w)+1)
+	for i := 0; i < len(w); i++ {
+		for j := i + 1; j <= len(w) && j-i <= h.maxLen; j++ {
+			pat, ok := h.patterns[string(w[i:j])]
+			if !ok {
+				continue
+			}
+			for k, v := range pat {
+				if v > values[i+k] {
+					values[i+k] = v
+				}
+			}
+		}
+	}
+
+	result := make([]int, 0, 4)
+	for p := h.LeftMin; p <= n-h.RightMin; p++ {
+		if values[p+1]%2 == 1 {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// isWordRune is the function that returns true if the specified rune can be
+// a part of a word to be hyphenated.
+func isWordRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsDigit(r)) && !isEastAsian(r)
+}
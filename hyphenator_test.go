@@ -0,0 +1,82 @@
+package linebreak_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sttk/linebreak"
+)
+
+func TestNewHyphenator_texPatterns(t *testing.T) {
+	tex := `% Liang's example patterns
+\patterns{ % patterns
+.hy3p he2n hena4 hen5at 1na n2at 1tio 2io o2n
+}
+\hyphenation{
+ta-ble
+}
+`
+	h, err := linebreak.NewHyphenator(strings.NewReader(tex))
+	assert.Nil(t, err)
+	assert.Equal(t, h.Hyphenate("hyphenation"), []int{2, 6})
+	assert.Equal(t, h.Hyphenate("Hyphenation"), []int{2, 6})
+	assert.Equal(t, h.Hyphenate("table"), []int{2})
+	assert.Equal(t, h.Hyphenate("hyp"), []int(nil))
+
+	h.LeftMin = 3
+	assert.Equal(t, h.Hyphenate("hyphenation"), []int{6})
+}
+
+func TestNewHyphenator_plainPatterns(t *testing.T) {
+	h, err := linebreak.NewHyphenator(strings.NewReader("1tion\nn1t"))
+	assert.Nil(t, err)
+	assert.Equal(t, h.Hyphenate("intention"), []int{2, 5})
+}
+
+func TestNewHyphenator_badPatterns(t *testing.T) {
+	_, err := linebreak.NewHyphenator(strings.NewReader("a12b"))
+	assert.Equal(t, err, linebreak.ErrBadPattern)
+
+	_, err = linebreak.NewHyphenator(strings.NewReader(`\patterns{ 1na`))
+	assert.Equal(t, err, linebreak.ErrBadPattern)
+}
+
+func TestEnglishHyphenator(t *testing.T) {
+	h := linebreak.EnglishHyphenator()
+	assert.Equal(t, h.Hyphenate("information"), []int{2, 5, 7})
+	assert.Equal(t, h.Hyphenate("remember"), []int{2, 5})
+	assert.Equal(t, h.Hyphenate("thinking"), []int{5})
+	assert.Equal(t, h.Hyphenate("string"), []int{})
+	assert.Equal(t, h.Hyphenate("table"), []int{2})
+}
+
+func TestEnglishHyphenator_independentInstances(t *testing.T) {
+	h1 := linebreak.EnglishHyphenator()
+	h1.LeftMin = 1
+	h1.RightMin = 1
+
+	h2 := linebreak.EnglishHyphenator()
+	assert.NotSame(t, h1, h2)
+	assert.Equal(t, h2.LeftMin, 2)
+	assert.Equal(t, h2.RightMin, 3)
+	assert.Equal(t, h2.Hyphenate("information"), []int{2, 5, 7})
+}
+
+// The expected hyphenation points are the same as the output of TeX.
+func TestEnglishHyphenator_sameAsTeX(t *testing.T) {
+	h := linebreak.EnglishHyphenator()
+	assert.Equal(t, h.Hyphenate("hyphenation"), []int{2, 6})
+	assert.Equal(t, h.Hyphenate("uniform"), []int{3})
+	assert.Equal(t, h.Hyphenate("unique"), []int{})
+	assert.Equal(t, h.Hyphenate("nothing"), []int{4})
+	assert.Equal(t, h.Hyphenate("bringing"), []int{5})
+	assert.Equal(t, h.Hyphenate("computer"), []int{3})
+	assert.Equal(t, h.Hyphenate("concatenation"), []int{3, 7, 9})
+	assert.Equal(t, h.Hyphenate("mathematics"), []int{4, 5, 8})
+	assert.Equal(t, h.Hyphenate("typesetting"), []int{4, 7})
+	assert.Equal(t, h.Hyphenate("supercalifragilisticexpialidocious"),
+		[]int{2, 5, 8, 13, 17, 20, 22, 24, 27, 29})
+	assert.Equal(t, h.Hyphenate("associate"), []int{2, 4})
+	assert.Equal(t, h.Hyphenate("project"), []int{})
+}
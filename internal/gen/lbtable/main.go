@@ -0,0 +1,356 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+// Command lbtable generates the tables of Unicode properties used by package
+// linebreak from the Unicode Character Database (UCD).
+//
+// The line breaking classes are generated into lb-table.go from
+// LineBreak.txt, and the grapheme cluster break properties, the
+// Extended_Pictographic property and the Indic_Conjunct_Break property are
+// generated into gb-table.go from auxiliary/GraphemeBreakProperty.txt,
+// emoji/emoji-data.txt and DerivedCoreProperties.txt.
+//
+// The version of the UCD is pinned by ucdVersion, and all the tables are
+// generated from the files of the same version.
+// The files are downloaded from unicode.org, or read from the directory
+// specified with the -d flag, which has the same layout as the ucd directory
+// on unicode.org.
+//
+// Usage:
+//
+//	go run ./internal/gen/lbtable [-d ucd-dir] [-o output-dir]
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// The version of the Unicode Character Database.
+const ucdVersion = "17.0.0"
+
+const ucdURL = "https://www.unicode.org/Public/" + ucdVersion + "/ucd/"
+
+const maxRune = 0x10FFFF
+
+type ucdRange struct {
+	first int
+	last  int
+	value string
+}
+
+func main() {
+	dir := flag.String("d", "", "path of the UCD directory (default: download)")
+	output := flag.String("o", ".", "path of the output directory")
+	flag.Parse()
+
+	if err := run(*dir, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "lbtable:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, output string) error {
+	lb, err := load(dir, "LineBreak.txt", "", "XX")
+	if err != nil {
+		return err
+	}
+	gcb, err := load(dir, "auxiliary/GraphemeBreakProperty.txt", "", "Other")
+	if err != nil {
+		return err
+	}
+	extPict, err := load(dir, "emoji/emoji-data.txt",
+		"Extended_Pictographic", "")
+	if err != nil {
+		return err
+	}
+	incb, err := load(dir, "DerivedCoreProperties.txt", "InCB", "None")
+	if err != nil {
+		return err
+	}
+
+	err = create(filepath.Join(output, "lb-table.go"), func(w io.Writer) {
+		writeLbTable(w, lb)
+	})
+	if err != nil {
+		return err
+	}
+	return create(filepath.Join(output, "gb-table.go"), func(w io.Writer) {
+		writeGbTable(w, gcb, extPict, incb)
+	})
+}
+
+// load is the function that reads the specified file of the UCD and returns
+// the ranges of code points which have the same value of the specified
+// property, except the ranges of the specified default value.
+// If prop is empty, the file is regarded to have only one property.
+func load(dir, path, prop, deflt string) ([]ucdRange, error) {
+	r, err := open(dir, path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	ranges, err := parse(r, filepath.Base(path), prop, deflt)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return ranges, nil
+}
+
+func open(dir, path string) (io.ReadCloser, error) {
+	if len(dir) > 0 {
+		return os.Open(filepath.Join(dir, filepath.FromSlash(path)))
+	}
+
+	url := ucdURL + path
+	res, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+		return nil, fmt.Errorf("%s: %s", url, res.Status)
+	}
+	return res.Body, nil
+}
+
+func create(path string, write func(io.Writer)) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	write(w)
+	return w.Flush()
+}
+
+// parse is the function that reads a file of the UCD and returns the ranges
+// of code points which have the same value of the specified property, except
+// the ranges of the specified default value.
+// The code points which are not listed in the file get the default values
+// specified by @missing lines.
+func parse(r io.Reader, name, prop, deflt string) ([]ucdRange, error) {
+	values := make([]string, maxRune+1)
+	var defaults []ucdRange
+
+	base := strings.TrimSuffix(name, ".txt")
+	versioned := false
+
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+
+		// The first line is the file name with the version. Some files, like
+		// emoji-data.txt, have the file name without the version, and then
+		// the version is written in the following comment lines.
+		if n == 1 {
+			switch strings.TrimSpace(line) {
+			case "# " + base + "-" + ucdVersion + ".txt":
+				versioned = true
+				continue
+			case "# " + name:
+				continue
+			}
+			return nil, fmt.Errorf("bad header: %q", line)
+		}
+
+		if strings.HasPrefix(line, "# @missing:") {
+			missing := strings.TrimPrefix(line, "# @missing:")
+			rng, ok, err := parseLine(missing, prop)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", n, err)
+			}
+			if ok {
+				defaults = append(defaults, rng)
+			}
+			continue
+		}
+
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			if !versioned && strings.Contains(line[i:], "Version "+
+				strings.TrimSuffix(ucdVersion, ".0")+" ") {
+				versioned = true
+			}
+			line = line[0:i]
+		}
+		if len(strings.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		if !versioned {
+			return nil, fmt.Errorf("not of Unicode %s", ucdVersion)
+		}
+
+		rng, ok, err := parseLine(line, prop)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+		if !ok {
+			continue
+		}
+		for c := rng.first; c <= rng.last; c++ {
+			values[c] = rng.value
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if !versioned {
+		return nil, fmt.Errorf("not of Unicode %s", ucdVersion)
+	}
+
+	// the later @missing lines take precedence over the earlier ones.
+	for i := len(defaults) - 1; i >= 0; i-- {
+		rng := defaults[i]
+		for c := rng.first; c <= rng.last; c++ {
+			if len(values[c]) == 0 {
+				values[c] = rng.value
+			}
+		}
+	}
+
+	var ranges []ucdRange
+	for c := 0; c <= maxRune; c++ {
+		value := values[c]
+		if len(value) == 0 || value == deflt {
+			continue
+		}
+		n := len(ranges)
+		if n > 0 && ranges[n-1].last+1 == c && ranges[n-1].value == value {
+			ranges[n-1].last = c
+			continue
+		}
+		ranges = append(ranges, ucdRange{first: c, last: c, value: value})
+	}
+	return ranges, nil
+}
+
+// parseLine is the function that parses a data line of a file of the UCD,
+// and returns the range and the value of the specified property, and whether
+// the line is of the property.
+// A line of a binary property has the property name as its value.
+func parseLine(line, prop string) (ucdRange, bool, error) {
+	fields := strings.Split(line, ";")
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	var value string
+	switch {
+	case len(prop) == 0 && len(fields) == 2:
+		value = fields[1]
+	case len(prop) > 0 && len(fields) == 2:
+		if fields[1] != prop {
+			return ucdRange{}, false, nil
+		}
+		value = prop
+	case len(prop) > 0 && len(fields) == 3:
+		if fields[1] != prop {
+			return ucdRange{}, false, nil
+		}
+		value = fields[2]
+	case len(fields) < 2:
+		return ucdRange{}, false, fmt.Errorf("bad line: %q", line)
+	default:
+		return ucdRange{}, false, nil
+	}
+
+	codes := fields[0]
+	first, last := codes, codes
+	if i := strings.Index(codes, ".."); i >= 0 {
+		first, last = codes[0:i], codes[i+2:]
+	}
+	f, err := strconv.ParseUint(first, 16, 32)
+	if err != nil {
+		return ucdRange{}, false, err
+	}
+	l, err := strconv.ParseUint(last, 16, 32)
+	if err != nil {
+		return ucdRange{}, false, err
+	}
+	if f > l || l > maxRune {
+		return ucdRange{}, false, fmt.Errorf("bad range: %q", codes)
+	}
+	return ucdRange{first: int(f), last: int(l), value: value}, true, nil
+}
+
+func writeLbTable(w io.Writer, ranges []ucdRange) {
+	fmt.Fprintf(w, "// Code generated by internal/gen/lbtable from "+
+		"LineBreak-%s.txt. DO NOT EDIT.\n", ucdVersion)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "package linebreak")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// ucdVersion is the version of the Unicode Character Database "+
+		"from which")
+	fmt.Fprintln(w, "// lbClassTable is generated.")
+	fmt.Fprintf(w, "const ucdVersion = %q\n", ucdVersion)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// lbClassTable is the table of the line breaking classes of "+
+		"UAX14, which is")
+	fmt.Fprintln(w, "// sorted by code points. The runes not in this table are "+
+		"of the class XX.")
+	fmt.Fprintln(w, "var lbClassTable = []lbClassRange{")
+	for _, r := range ranges {
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X, lbc_%s},\n", r.first, r.last, r.value)
+	}
+	fmt.Fprintln(w, "}")
+}
+
+// The names of the grapheme cluster break properties in package linebreak
+// which differ from the names in the UCD.
+var gcbNames = map[string]string{
+	"Regional_Indicator": "RI",
+}
+
+func writeGbTable(w io.Writer, gcb, extPict, incb []ucdRange) {
+	fmt.Fprintf(w, "// Code generated by internal/gen/lbtable from "+
+		"GraphemeBreakProperty-%[1]s.txt, emoji-data-%[1]s.txt and "+
+		"DerivedCoreProperties-%[1]s.txt. DO NOT EDIT.\n", ucdVersion)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "package linebreak")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// gcbTable is the table of the grapheme cluster break "+
+		"properties of UAX29,")
+	fmt.Fprintln(w, "// which is sorted by code points. The runes not in this "+
+		"table are of the")
+	fmt.Fprintln(w, "// property Other.")
+	fmt.Fprintln(w, "var gcbTable = []gcbRange{")
+	for _, r := range gcb {
+		name, ok := gcbNames[r.value]
+		if !ok {
+			name = r.value
+		}
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X, gcb_%s},\n", r.first, r.last, name)
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// extPictTable is the table of the runes which have the "+
+		"Extended_Pictographic")
+	fmt.Fprintln(w, "// property, which is sorted by code points.")
+	fmt.Fprintln(w, "var extPictTable = [][2]rune{")
+	for _, r := range extPict {
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X},\n", r.first, r.last)
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// incbTable is the table of the Indic_Conjunct_Break "+
+		"properties, which is")
+	fmt.Fprintln(w, "// sorted by code points. The runes not in this table are "+
+		"of the property None.")
+	fmt.Fprintln(w, "var incbTable = []incbRange{")
+	for _, r := range incb {
+		fmt.Fprintf(w, "\t{0x%04X, 0x%04X, incb_%s},\n", r.first, r.last, r.value)
+	}
+	fmt.Fprintln(w, "}")
+}
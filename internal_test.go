@@ -16,3 +16,17 @@ func TestTrimRight(t *testing.T) {
 	assert.Equal(t, trimRight([]rune{0x31, 0x32}), []rune{0x31, 0x32})
 	assert.Equal(t, trimRight([]rune{0x20, 0x20, 0x20}), []rune{})
 }
+
+func TestRuneWidth_combiningMark(t *testing.T) {
+	assert.Equal(t, RuneWidth(0x0301), 0)
+	assert.Equal(t, RuneWidth(0x20DD), 0)
+	assert.Equal(t, RuneWidth(0x200D), 0)
+}
+
+func TestRuneWidth_spaceSeparator(t *testing.T) {
+	assert.Equal(t, RuneWidth(0x00A0), 1)
+	assert.Equal(t, RuneWidth(0x202F), 1)
+	assert.Equal(t, RuneWidth(0x3000), 2)
+	assert.Equal(t, RuneWidth(0x200B), 0)
+	assert.Equal(t, RuneWidth(0x2060), 0)
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+//go:generate go run ./internal/gen/lbtable -o .
+
+// Line breaking class of Unicode Standard Annex #14 (UAX14).
+type lbClass uint8
+
+const (
+	lbc_XX  lbClass = iota // Unknown
+	lbc_AI                 // Ambiguous (Alphabetic or Ideographic)
+	lbc_AK                 // Aksara
+	lbc_AL                 // Alphabetic
+	lbc_AP                 // Aksara Pre-Base
+	lbc_AS                 // Aksara Start
+	lbc_B2                 // Break Opportunity Before and After
+	lbc_BA                 // Break After
+	lbc_BB                 // Break Before
+	lbc_BK                 // Mandatory Break
+	lbc_CB                 // Contingent Break Opportunity
+	lbc_CJ                 // Conditional Japanese Starter
+	lbc_CL                 // Close Punctuation
+	lbc_CM                 // Combining Mark
+	lbc_CP                 // Close Parenthesis
+	lbc_CR                 // Carriage Return
+	lbc_EB                 // Emoji Base
+	lbc_EM                 // Emoji Modifier
+	lbc_EX                 // Exclamation/Interrogation
+	lbc_GL                 // Non-breaking ("Glue")
+	lbc_H2                 // Hangul LV Syllable
+	lbc_H3                 // Hangul LVT Syllable
+	lbc_HH                 // Unambiguous Hyphen
+	lbc_HL                 // Hebrew Letter
+	lbc_HY                 // Hyphen
+	lbc_ID                 // Ideographic
+	lbc_IN                 // Inseparable
+	lbc_IS                 // Infix Numeric Separator
+	lbc_JL                 // Hangul L Jamo
+	lbc_JT                 // Hangul T Jamo
+	lbc_JV                 // Hangul V Jamo
+	lbc_LF                 // Line Feed
+	lbc_NL                 // Next Line
+	lbc_NS                 // Nonstarter
+	lbc_NU                 // Numeric
+	lbc_OP                 // Open Punctuation
+	lbc_PO                 // Postfix Numeric
+	lbc_PR                 // Prefix Numeric
+	lbc_QU                 // Quotation
+	lbc_RI                 // Regional Indicator
+	lbc_SA                 // Complex Context Dependent (South East Asian)
+	lbc_SG                 // Surrogate
+	lbc_SP                 // Space
+	lbc_SY                 // Symbols Allowing Break After
+	lbc_VF                 // Virama Final
+	lbc_VI                 // Virama
+	lbc_WJ                 // Word Joiner
+	lbc_ZW                 // Zero Width Space
+	lbc_ZWJ                // Zero Width Joiner
+)
+
+type lbClassRange struct {
+	first rune
+	last  rune
+	class lbClass
+}
+
+// lineBreakClass is the function that returns the line breaking class of the
+// specified rune, which is looked up in lbClassTable.
+func lineBreakClass(r rune) lbClass {
+	lo, hi := 0, len(lbClassTable)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		rng := lbClassTable[m]
+		if r < rng.first {
+			hi = m
+		} else if r > rng.last {
+			lo = m + 1
+		} else {
+			return rng.class
+		}
+	}
+	return lbc_XX
+}
+
+// isAssigned is the function that returns true if the specified rune is
+// assigned to a character, which is not of the general category Cn.
+func isAssigned(r rune) bool {
+	return unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S,
+		unicode.Z, unicode.Cc, unicode.Cf, unicode.Co, unicode.Cs)
+}
+
+// isExtendedPictographic is the function that returns true if the specified
+// rune has the Extended_Pictographic property of Unicode emoji data, which
+// is looked up in extPictTable.
+func isExtendedPictographic(r rune) bool {
+	lo, hi := 0, len(extPictTable)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		rng := extPictTable[m]
+		if r < rng[0] {
+			hi = m
+		} else if r > rng[1] {
+			lo = m + 1
+		} else {
+			return true
+		}
+	}
+	return false
+}
+
+func isEastAsian(r rune) bool {
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth,
+		width.EastAsianHalfwidth:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,2929 @@
+// Code generated by internal/gen/lbtable from LineBreak-17.0.0.txt. DO NOT EDIT.
+
+package linebreak
+
+// ucdVersion is the version of the Unicode Character Database from which
+// lbClassTable is generated.
+const ucdVersion = "17.0.0"
+
+// lbClassTable is the table of the line breaking classes of UAX14, which is
+// sorted by code points. The runes not in this table are of the class XX.
+var lbClassTable = []lbClassRange{
+	{0x0000, 0x0008, lbc_CM},
+	{0x0009, 0x0009, lbc_BA},
+	{0x000A, 0x000A, lbc_LF},
+	{0x000B, 0x000C, lbc_BK},
+	{0x000D, 0x000D, lbc_CR},
+	{0x000E, 0x001F, lbc_CM},
+	{0x0020, 0x0020, lbc_SP},
+	{0x0021, 0x0021, lbc_EX},
+	{0x0022, 0x0022, lbc_QU},
+	{0x0023, 0x0023, lbc_AL},
+	{0x0024, 0x0024, lbc_PR},
+	{0x0025, 0x0025, lbc_PO},
+	{0x0026, 0x0026, lbc_AL},
+	{0x0027, 0x0027, lbc_QU},
+	{0x0028, 0x0028, lbc_OP},
+	{0x0029, 0x0029, lbc_CP},
+	{0x002A, 0x002A, lbc_AL},
+	{0x002B, 0x002B, lbc_PR},
+	{0x002C, 0x002C, lbc_IS},
+	{0x002D, 0x002D, lbc_HY},
+	{0x002E, 0x002E, lbc_IS},
+	{0x002F, 0x002F, lbc_SY},
+	{0x0030, 0x0039, lbc_NU},
+	{0x003A, 0x003B, lbc_IS},
+	{0x003C, 0x003E, lbc_AL},
+	{0x003F, 0x003F, lbc_EX},
+	{0x0040, 0x005A, lbc_AL},
+	{0x005B, 0x005B, lbc_OP},
+	{0x005C, 0x005C, lbc_PR},
+	{0x005D, 0x005D, lbc_CP},
+	{0x005E, 0x007A, lbc_AL},
+	{0x007B, 0x007B, lbc_OP},
+	{0x007C, 0x007C, lbc_BA},
+	{0x007D, 0x007D, lbc_CL},
+	{0x007E, 0x007E, lbc_AL},
+	{0x007F, 0x0084, lbc_CM},
+	{0x0085, 0x0085, lbc_NL},
+	{0x0086, 0x009F, lbc_CM},
+	{0x00A0, 0x00A0, lbc_GL},
+	{0x00A1, 0x00A1, lbc_OP},
+	{0x00A2, 0x00A2, lbc_PO},
+	{0x00A3, 0x00A5, lbc_PR},
+	{0x00A6, 0x00A6, lbc_AL},
+	{0x00A7, 0x00A8, lbc_AI},
+	{0x00A9, 0x00A9, lbc_AL},
+	{0x00AA, 0x00AA, lbc_AI},
+	{0x00AB, 0x00AB, lbc_QU},
+	{0x00AC, 0x00AC, lbc_AL},
+	{0x00AD, 0x00AD, lbc_BA},
+	{0x00AE, 0x00AF, lbc_AL},
+	{0x00B0, 0x00B0, lbc_PO},
+	{0x00B1, 0x00B1, lbc_PR},
+	{0x00B2, 0x00B3, lbc_AI},
+	{0x00B4, 0x00B4, lbc_BB},
+	{0x00B5, 0x00B5, lbc_AL},
+	{0x00B6, 0x00BA, lbc_AI},
+	{0x00BB, 0x00BB, lbc_QU},
+	{0x00BC, 0x00BE, lbc_AI},
+	{0x00BF, 0x00BF, lbc_OP},
+	{0x00C0, 0x00D6, lbc_AL},
+	{0x00D7, 0x00D7, lbc_AI},
+	{0x00D8, 0x00F6, lbc_AL},
+	{0x00F7, 0x00F7, lbc_AI},
+	{0x00F8, 0x02C6, lbc_AL},
+	{0x02C7, 0x02C7, lbc_AI},
+	{0x02C8, 0x02C8, lbc_BB},
+	{0x02C9, 0x02CB, lbc_AI},
+	{0x02CC, 0x02CC, lbc_BB},
+	{0x02CD, 0x02CD, lbc_AI},
+	{0x02CE, 0x02CF, lbc_AL},
+	{0x02D0, 0x02D0, lbc_AI},
+	{0x02D1, 0x02D7, lbc_AL},
+	{0x02D8, 0x02DB, lbc_AI},
+	{0x02DC, 0x02DC, lbc_AL},
+	{0x02DD, 0x02DD, lbc_AI},
+	{0x02DE, 0x02DE, lbc_AL},
+	{0x02DF, 0x02DF, lbc_BB},
+	{0x02E0, 0x02FF, lbc_AL},
+	{0x0300, 0x035B, lbc_CM},
+	{0x035C, 0x0362, lbc_GL},
+	{0x0363, 0x036F, lbc_CM},
+	{0x0370, 0x0377, lbc_AL},
+	{0x037A, 0x037D, lbc_AL},
+	{0x037E, 0x037E, lbc_IS},
+	{0x037F, 0x037F, lbc_AL},
+	{0x0384, 0x038A, lbc_AL},
+	{0x038C, 0x038C, lbc_AL},
+	{0x038E, 0x03A1, lbc_AL},
+	{0x03A3, 0x0482, lbc_AL},
+	{0x0483, 0x0489, lbc_CM},
+	{0x048A, 0x052F, lbc_AL},
+	{0x0531, 0x0556, lbc_AL},
+	{0x0559, 0x0588, lbc_AL},
+	{0x0589, 0x0589, lbc_IS},
+	{0x058A, 0x058A, lbc_HH},
+	{0x058D, 0x058E, lbc_AL},
+	{0x058F, 0x058F, lbc_PR},
+	{0x0591, 0x05BD, lbc_CM},
+	{0x05BE, 0x05BE, lbc_HH},
+	{0x05BF, 0x05BF, lbc_CM},
+	{0x05C0, 0x05C0, lbc_AL},
+	{0x05C1, 0x05C2, lbc_CM},
+	{0x05C3, 0x05C3, lbc_AL},
+	{0x05C4, 0x05C5, lbc_CM},
+	{0x05C6, 0x05C6, lbc_EX},
+	{0x05C7, 0x05C7, lbc_CM},
+	{0x05D0, 0x05EA, lbc_HL},
+	{0x05EF, 0x05F2, lbc_HL},
+	{0x05F3, 0x05F4, lbc_AL},
+	{0x0600, 0x0605, lbc_NU},
+	{0x0606, 0x0608, lbc_AL},
+	{0x0609, 0x060B, lbc_PO},
+	{0x060C, 0x060D, lbc_IS},
+	{0x060E, 0x060F, lbc_AL},
+	{0x0610, 0x061A, lbc_CM},
+	{0x061B, 0x061B, lbc_EX},
+	{0x061C, 0x061C, lbc_CM},
+	{0x061D, 0x061F, lbc_EX},
+	{0x0620, 0x064A, lbc_AL},
+	{0x064B, 0x065F, lbc_CM},
+	{0x0660, 0x0669, lbc_NU},
+	{0x066A, 0x066A, lbc_PO},
+	{0x066B, 0x066C, lbc_NU},
+	{0x066D, 0x066F, lbc_AL},
+	{0x0670, 0x0670, lbc_CM},
+	{0x0671, 0x06D3, lbc_AL},
+	{0x06D4, 0x06D4, lbc_EX},
+	{0x06D5, 0x06D5, lbc_AL},
+	{0x06D6, 0x06DC, lbc_CM},
+	{0x06DD, 0x06DD, lbc_NU},
+	{0x06DE, 0x06DE, lbc_AL},
+	{0x06DF, 0x06E4, lbc_CM},
+	{0x06E5, 0x06E6, lbc_AL},
+	{0x06E7, 0x06E8, lbc_CM},
+	{0x06E9, 0x06E9, lbc_AL},
+	{0x06EA, 0x06ED, lbc_CM},
+	{0x06EE, 0x06EF, lbc_AL},
+	{0x06F0, 0x06F9, lbc_NU},
+	{0x06FA, 0x070D, lbc_AL},
+	{0x070F, 0x0710, lbc_AL},
+	{0x0711, 0x0711, lbc_CM},
+	{0x0712, 0x072F, lbc_AL},
+	{0x0730, 0x074A, lbc_CM},
+	{0x074D, 0x07A5, lbc_AL},
+	{0x07A6, 0x07B0, lbc_CM},
+	{0x07B1, 0x07B1, lbc_AL},
+	{0x07C0, 0x07C9, lbc_NU},
+	{0x07CA, 0x07EA, lbc_AL},
+	{0x07EB, 0x07F3, lbc_CM},
+	{0x07F4, 0x07F7, lbc_AL},
+	{0x07F8, 0x07F8, lbc_IS},
+	{0x07F9, 0x07F9, lbc_EX},
+	{0x07FA, 0x07FA, lbc_AL},
+	{0x07FD, 0x07FD, lbc_CM},
+	{0x07FE, 0x07FF, lbc_PR},
+	{0x0800, 0x0815, lbc_AL},
+	{0x0816, 0x0819, lbc_CM},
+	{0x081A, 0x081A, lbc_AL},
+	{0x081B, 0x0823, lbc_CM},
+	{0x0824, 0x0824, lbc_AL},
+	{0x0825, 0x0827, lbc_CM},
+	{0x0828, 0x0828, lbc_AL},
+	{0x0829, 0x082D, lbc_CM},
+	{0x0830, 0x083E, lbc_AL},
+	{0x0840, 0x0858, lbc_AL},
+	{0x0859, 0x085B, lbc_CM},
+	{0x085E, 0x085E, lbc_AL},
+	{0x0860, 0x086A, lbc_AL},
+	{0x0870, 0x088F, lbc_AL},
+	{0x0890, 0x0891, lbc_NU},
+	{0x0897, 0x089F, lbc_CM},
+	{0x08A0, 0x08C9, lbc_AL},
+	{0x08CA, 0x08E1, lbc_CM},
+	{0x08E2, 0x08E2, lbc_NU},
+	{0x08E3, 0x0903, lbc_CM},
+	{0x0904, 0x0939, lbc_AL},
+	{0x093A, 0x093C, lbc_CM},
+	{0x093D, 0x093D, lbc_AL},
+	{0x093E, 0x094F, lbc_CM},
+	{0x0950, 0x0950, lbc_AL},
+	{0x0951, 0x0957, lbc_CM},
+	{0x0958, 0x0961, lbc_AL},
+	{0x0962, 0x0963, lbc_CM},
+	{0x0964, 0x0965, lbc_BA},
+	{0x0966, 0x096F, lbc_NU},
+	{0x0970, 0x0980, lbc_AL},
+	{0x0981, 0x0983, lbc_CM},
+	{0x0985, 0x098C, lbc_AL},
+	{0x098F, 0x0990, lbc_AL},
+	{0x0993, 0x09A8, lbc_AL},
+	{0x09AA, 0x09B0, lbc_AL},
+	{0x09B2, 0x09B2, lbc_AL},
+	{0x09B6, 0x09B9, lbc_AL},
+	{0x09BC, 0x09BC, lbc_CM},
+	{0x09BD, 0x09BD, lbc_AL},
+	{0x09BE, 0x09C4, lbc_CM},
+	{0x09C7, 0x09C8, lbc_CM},
+	{0x09CB, 0x09CD, lbc_CM},
+	{0x09CE, 0x09CE, lbc_AL},
+	{0x09D7, 0x09D7, lbc_CM},
+	{0x09DC, 0x09DD, lbc_AL},
+	{0x09DF, 0x09E1, lbc_AL},
+	{0x09E2, 0x09E3, lbc_CM},
+	{0x09E6, 0x09EF, lbc_NU},
+	{0x09F0, 0x09F1, lbc_AL},
+	{0x09F2, 0x09F3, lbc_PO},
+	{0x09F4, 0x09F8, lbc_AL},
+	{0x09F9, 0x09F9, lbc_PO},
+	{0x09FA, 0x09FA, lbc_AL},
+	{0x09FB, 0x09FB, lbc_PR},
+	{0x09FC, 0x09FD, lbc_AL},
+	{0x09FE, 0x09FE, lbc_CM},
+	{0x0A01, 0x0A03, lbc_CM},
+	{0x0A05, 0x0A0A, lbc_AL},
+	{0x0A0F, 0x0A10, lbc_AL},
+	{0x0A13, 0x0A28, lbc_AL},
+	{0x0A2A, 0x0A30, lbc_AL},
+	{0x0A32, 0x0A33, lbc_AL},
+	{0x0A35, 0x0A36, lbc_AL},
+	{0x0A38, 0x0A39, lbc_AL},
+	{0x0A3C, 0x0A3C, lbc_CM},
+	{0x0A3E, 0x0A42, lbc_CM},
+	{0x0A47, 0x0A48, lbc_CM},
+	{0x0A4B, 0x0A4D, lbc_CM},
+	{0x0A51, 0x0A51, lbc_CM},
+	{0x0A59, 0x0A5C, lbc_AL},
+	{0x0A5E, 0x0A5E, lbc_AL},
+	{0x0A66, 0x0A6F, lbc_NU},
+	{0x0A70, 0x0A71, lbc_CM},
+	{0x0A72, 0x0A74, lbc_AL},
+	{0x0A75, 0x0A75, lbc_CM},
+	{0x0A76, 0x0A76, lbc_AL},
+	{0x0A81, 0x0A83, lbc_CM},
+	{0x0A85, 0x0A8D, lbc_AL},
+	{0x0A8F, 0x0A91, lbc_AL},
+	{0x0A93, 0x0AA8, lbc_AL},
+	{0x0AAA, 0x0AB0, lbc_AL},
+	{0x0AB2, 0x0AB3, lbc_AL},
+	{0x0AB5, 0x0AB9, lbc_AL},
+	{0x0ABC, 0x0ABC, lbc_CM},
+	{0x0ABD, 0x0ABD, lbc_AL},
+	{0x0ABE, 0x0AC5, lbc_CM},
+	{0x0AC7, 0x0AC9, lbc_CM},
+	{0x0ACB, 0x0ACD, lbc_CM},
+	{0x0AD0, 0x0AD0, lbc_AL},
+	{0x0AE0, 0x0AE1, lbc_AL},
+	{0x0AE2, 0x0AE3, lbc_CM},
+	{0x0AE6, 0x0AEF, lbc_NU},
+	{0x0AF0, 0x0AF0, lbc_AL},
+	{0x0AF1, 0x0AF1, lbc_PR},
+	{0x0AF9, 0x0AF9, lbc_AL},
+	{0x0AFA, 0x0AFF, lbc_CM},
+	{0x0B01, 0x0B03, lbc_CM},
+	{0x0B05, 0x0B0C, lbc_AL},
+	{0x0B0F, 0x0B10, lbc_AL},
+	{0x0B13, 0x0B28, lbc_AL},
+	{0x0B2A, 0x0B30, lbc_AL},
+	{0x0B32, 0x0B33, lbc_AL},
+	{0x0B35, 0x0B39, lbc_AL},
+	{0x0B3C, 0x0B3C, lbc_CM},
+	{0x0B3D, 0x0B3D, lbc_AL},
+	{0x0B3E, 0x0B44, lbc_CM},
+	{0x0B47, 0x0B48, lbc_CM},
+	{0x0B4B, 0x0B4D, lbc_CM},
+	{0x0B55, 0x0B57, lbc_CM},
+	{0x0B5C, 0x0B5D, lbc_AL},
+	{0x0B5F, 0x0B61, lbc_AL},
+	{0x0B62, 0x0B63, lbc_CM},
+	{0x0B66, 0x0B6F, lbc_NU},
+	{0x0B70, 0x0B77, lbc_AL},
+	{0x0B82, 0x0B82, lbc_CM},
+	{0x0B83, 0x0B83, lbc_AL},
+	{0x0B85, 0x0B8A, lbc_AL},
+	{0x0B8E, 0x0B90, lbc_AL},
+	{0x0B92, 0x0B95, lbc_AL},
+	{0x0B99, 0x0B9A, lbc_AL},
+	{0x0B9C, 0x0B9C, lbc_AL},
+	{0x0B9E, 0x0B9F, lbc_AL},
+	{0x0BA3, 0x0BA4, lbc_AL},
+	{0x0BA8, 0x0BAA, lbc_AL},
+	{0x0BAE, 0x0BB9, lbc_AL},
+	{0x0BBE, 0x0BC2, lbc_CM},
+	{0x0BC6, 0x0BC8, lbc_CM},
+	{0x0BCA, 0x0BCD, lbc_CM},
+	{0x0BD0, 0x0BD0, lbc_AL},
+	{0x0BD7, 0x0BD7, lbc_CM},
+	{0x0BE6, 0x0BEF, lbc_NU},
+	{0x0BF0, 0x0BF8, lbc_AL},
+	{0x0BF9, 0x0BF9, lbc_PR},
+	{0x0BFA, 0x0BFA, lbc_AL},
+	{0x0C00, 0x0C04, lbc_CM},
+	{0x0C05, 0x0C0C, lbc_AL},
+	{0x0C0E, 0x0C10, lbc_AL},
+	{0x0C12, 0x0C28, lbc_AL},
+	{0x0C2A, 0x0C39, lbc_AL},
+	{0x0C3C, 0x0C3C, lbc_CM},
+	{0x0C3D, 0x0C3D, lbc_AL},
+	{0x0C3E, 0x0C44, lbc_CM},
+	{0x0C46, 0x0C48, lbc_CM},
+	{0x0C4A, 0x0C4D, lbc_CM},
+	{0x0C55, 0x0C56, lbc_CM},
+	{0x0C58, 0x0C5A, lbc_AL},
+	{0x0C5C, 0x0C5D, lbc_AL},
+	{0x0C60, 0x0C61, lbc_AL},
+	{0x0C62, 0x0C63, lbc_CM},
+	{0x0C66, 0x0C6F, lbc_NU},
+	{0x0C77, 0x0C77, lbc_BB},
+	{0x0C78, 0x0C80, lbc_AL},
+	{0x0C81, 0x0C83, lbc_CM},
+	{0x0C84, 0x0C84, lbc_BB},
+	{0x0C85, 0x0C8C, lbc_AL},
+	{0x0C8E, 0x0C90, lbc_AL},
+	{0x0C92, 0x0CA8, lbc_AL},
+	{0x0CAA, 0x0CB3, lbc_AL},
+	{0x0CB5, 0x0CB9, lbc_AL},
+	{0x0CBC, 0x0CBC, lbc_CM},
+	{0x0CBD, 0x0CBD, lbc_AL},
+	{0x0CBE, 0x0CC4, lbc_CM},
+	{0x0CC6, 0x0CC8, lbc_CM},
+	{0x0CCA, 0x0CCD, lbc_CM},
+	{0x0CD5, 0x0CD6, lbc_CM},
+	{0x0CDC, 0x0CDE, lbc_AL},
+	{0x0CE0, 0x0CE1, lbc_AL},
+	{0x0CE2, 0x0CE3, lbc_CM},
+	{0x0CE6, 0x0CEF, lbc_NU},
+	{0x0CF1, 0x0CF2, lbc_AL},
+	{0x0CF3, 0x0CF3, lbc_CM},
+	{0x0D00, 0x0D03, lbc_CM},
+	{0x0D04, 0x0D0C, lbc_AL},
+	{0x0D0E, 0x0D10, lbc_AL},
+	{0x0D12, 0x0D3A, lbc_AL},
+	{0x0D3B, 0x0D3C, lbc_CM},
+	{0x0D3D, 0x0D3D, lbc_AL},
+	{0x0D3E, 0x0D44, lbc_CM},
+	{0x0D46, 0x0D48, lbc_CM},
+	{0x0D4A, 0x0D4D, lbc_CM},
+	{0x0D4E, 0x0D4F, lbc_AL},
+	{0x0D54, 0x0D56, lbc_AL},
+	{0x0D57, 0x0D57, lbc_CM},
+	{0x0D58, 0x0D61, lbc_AL},
+	{0x0D62, 0x0D63, lbc_CM},
+	{0x0D66, 0x0D6F, lbc_NU},
+	{0x0D70, 0x0D78, lbc_AL},
+	{0x0D79, 0x0D79, lbc_PO},
+	{0x0D7A, 0x0D7F, lbc_AL},
+	{0x0D81, 0x0D83, lbc_CM},
+	{0x0D85, 0x0D96, lbc_AL},
+	{0x0D9A, 0x0DB1, lbc_AL},
+	{0x0DB3, 0x0DBB, lbc_AL},
+	{0x0DBD, 0x0DBD, lbc_AL},
+	{0x0DC0, 0x0DC6, lbc_AL},
+	{0x0DCA, 0x0DCA, lbc_CM},
+	{0x0DCF, 0x0DD4, lbc_CM},
+	{0x0DD6, 0x0DD6, lbc_CM},
+	{0x0DD8, 0x0DDF, lbc_CM},
+	{0x0DE6, 0x0DEF, lbc_NU},
+	{0x0DF2, 0x0DF3, lbc_CM},
+	{0x0DF4, 0x0DF4, lbc_AL},
+	{0x0E01, 0x0E3A, lbc_SA},
+	{0x0E3F, 0x0E3F, lbc_PR},
+	{0x0E40, 0x0E4E, lbc_SA},
+	{0x0E4F, 0x0E4F, lbc_AL},
+	{0x0E50, 0x0E59, lbc_NU},
+	{0x0E5A, 0x0E5B, lbc_BA},
+	{0x0E81, 0x0E82, lbc_SA},
+	{0x0E84, 0x0E84, lbc_SA},
+	{0x0E86, 0x0E8A, lbc_SA},
+	{0x0E8C, 0x0EA3, lbc_SA},
+	{0x0EA5, 0x0EA5, lbc_SA},
+	{0x0EA7, 0x0EBD, lbc_SA},
+	{0x0EC0, 0x0EC4, lbc_SA},
+	{0x0EC6, 0x0EC6, lbc_SA},
+	{0x0EC8, 0x0ECE, lbc_SA},
+	{0x0ED0, 0x0ED9, lbc_NU},
+	{0x0EDC, 0x0EDF, lbc_SA},
+	{0x0F00, 0x0F00, lbc_AL},
+	{0x0F01, 0x0F04, lbc_BB},
+	{0x0F05, 0x0F05, lbc_AL},
+	{0x0F06, 0x0F07, lbc_BB},
+	{0x0F08, 0x0F08, lbc_GL},
+	{0x0F09, 0x0F0A, lbc_BB},
+	{0x0F0B, 0x0F0B, lbc_BA},
+	{0x0F0C, 0x0F0C, lbc_GL},
+	{0x0F0D, 0x0F11, lbc_EX},
+	{0x0F12, 0x0F12, lbc_GL},
+	{0x0F13, 0x0F13, lbc_AL},
+	{0x0F14, 0x0F14, lbc_EX},
+	{0x0F15, 0x0F17, lbc_AL},
+	{0x0F18, 0x0F19, lbc_CM},
+	{0x0F1A, 0x0F1F, lbc_AL},
+	{0x0F20, 0x0F29, lbc_NU},
+	{0x0F2A, 0x0F33, lbc_AL},
+	{0x0F34, 0x0F34, lbc_BA},
+	{0x0F35, 0x0F35, lbc_CM},
+	{0x0F36, 0x0F36, lbc_AL},
+	{0x0F37, 0x0F37, lbc_CM},
+	{0x0F38, 0x0F38, lbc_AL},
+	{0x0F39, 0x0F39, lbc_CM},
+	{0x0F3A, 0x0F3A, lbc_OP},
+	{0x0F3B, 0x0F3B, lbc_CL},
+	{0x0F3C, 0x0F3C, lbc_OP},
+	{0x0F3D, 0x0F3D, lbc_CL},
+	{0x0F3E, 0x0F3F, lbc_CM},
+	{0x0F40, 0x0F47, lbc_AL},
+	{0x0F49, 0x0F6C, lbc_AL},
+	{0x0F71, 0x0F7E, lbc_CM},
+	{0x0F7F, 0x0F7F, lbc_BA},
+	{0x0F80, 0x0F84, lbc_CM},
+	{0x0F85, 0x0F85, lbc_BA},
+	{0x0F86, 0x0F87, lbc_CM},
+	{0x0F88, 0x0F8C, lbc_AL},
+	{0x0F8D, 0x0F97, lbc_CM},
+	{0x0F99, 0x0FBC, lbc_CM},
+	{0x0FBE, 0x0FBF, lbc_BA},
+	{0x0FC0, 0x0FC5, lbc_AL},
+	{0x0FC6, 0x0FC6, lbc_CM},
+	{0x0FC7, 0x0FCC, lbc_AL},
+	{0x0FCE, 0x0FCF, lbc_AL},
+	{0x0FD0, 0x0FD1, lbc_BB},
+	{0x0FD2, 0x0FD2, lbc_BA},
+	{0x0FD3, 0x0FD3, lbc_BB},
+	{0x0FD4, 0x0FD8, lbc_AL},
+	{0x0FD9, 0x0FDA, lbc_GL},
+	{0x1000, 0x103F, lbc_SA},
+	{0x1040, 0x1049, lbc_NU},
+	{0x104A, 0x104B, lbc_BA},
+	{0x104C, 0x104F, lbc_AL},
+	{0x1050, 0x108F, lbc_SA},
+	{0x1090, 0x1099, lbc_NU},
+	{0x109A, 0x109F, lbc_SA},
+	{0x10A0, 0x10C5, lbc_AL},
+	{0x10C7, 0x10C7, lbc_AL},
+	{0x10CD, 0x10CD, lbc_AL},
+	{0x10D0, 0x10FF, lbc_AL},
+	{0x1100, 0x115F, lbc_JL},
+	{0x1160, 0x11A7, lbc_JV},
+	{0x11A8, 0x11FF, lbc_JT},
+	{0x1200, 0x1248, lbc_AL},
+	{0x124A, 0x124D, lbc_AL},
+	{0x1250, 0x1256, lbc_AL},
+	{0x1258, 0x1258, lbc_AL},
+	{0x125A, 0x125D, lbc_AL},
+	{0x1260, 0x1288, lbc_AL},
+	{0x128A, 0x128D, lbc_AL},
+	{0x1290, 0x12B0, lbc_AL},
+	{0x12B2, 0x12B5, lbc_AL},
+	{0x12B8, 0x12BE, lbc_AL},
+	{0x12C0, 0x12C0, lbc_AL},
+	{0x12C2, 0x12C5, lbc_AL},
+	{0x12C8, 0x12D6, lbc_AL},
+	{0x12D8, 0x1310, lbc_AL},
+	{0x1312, 0x1315, lbc_AL},
+	{0x1318, 0x135A, lbc_AL},
+	{0x135D, 0x135F, lbc_CM},
+	{0x1360, 0x1360, lbc_AL},
+	{0x1361, 0x1361, lbc_BA},
+	{0x1362, 0x137C, lbc_AL},
+	{0x1380, 0x1399, lbc_AL},
+	{0x13A0, 0x13F5, lbc_AL},
+	{0x13F8, 0x13FD, lbc_AL},
+	{0x1400, 0x1400, lbc_HH},
+	{0x1401, 0x167F, lbc_AL},
+	{0x1680, 0x1680, lbc_BA},
+	{0x1681, 0x169A, lbc_AL},
+	{0x169B, 0x169B, lbc_OP},
+	{0x169C, 0x169C, lbc_CL},
+	{0x16A0, 0x16EA, lbc_AL},
+	{0x16EB, 0x16ED, lbc_BA},
+	{0x16EE, 0x16F8, lbc_AL},
+	{0x1700, 0x1711, lbc_AL},
+	{0x1712, 0x1715, lbc_CM},
+	{0x171F, 0x1731, lbc_AL},
+	{0x1732, 0x1734, lbc_CM},
+	{0x1735, 0x1736, lbc_BA},
+	{0x1740, 0x1751, lbc_AL},
+	{0x1752, 0x1753, lbc_CM},
+	{0x1760, 0x176C, lbc_AL},
+	{0x176E, 0x1770, lbc_AL},
+	{0x1772, 0x1773, lbc_CM},
+	{0x1780, 0x17D3, lbc_SA},
+	{0x17D4, 0x17D5, lbc_BA},
+	{0x17D6, 0x17D6, lbc_NS},
+	{0x17D7, 0x17D7, lbc_SA},
+	{0x17D8, 0x17D8, lbc_BA},
+	{0x17D9, 0x17D9, lbc_AL},
+	{0x17DA, 0x17DA, lbc_BA},
+	{0x17DB, 0x17DB, lbc_PR},
+	{0x17DC, 0x17DD, lbc_SA},
+	{0x17E0, 0x17E9, lbc_NU},
+	{0x17F0, 0x17F9, lbc_AL},
+	{0x1800, 0x1801, lbc_AL},
+	{0x1802, 0x1803, lbc_EX},
+	{0x1804, 0x1805, lbc_BA},
+	{0x1806, 0x1806, lbc_BB},
+	{0x1807, 0x1807, lbc_AL},
+	{0x1808, 0x1809, lbc_EX},
+	{0x180A, 0x180A, lbc_AL},
+	{0x180B, 0x180D, lbc_CM},
+	{0x180E, 0x180E, lbc_GL},
+	{0x180F, 0x180F, lbc_CM},
+	{0x1810, 0x1819, lbc_NU},
+	{0x1820, 0x1878, lbc_AL},
+	{0x1880, 0x1884, lbc_AL},
+	{0x1885, 0x1886, lbc_CM},
+	{0x1887, 0x18A8, lbc_AL},
+	{0x18A9, 0x18A9, lbc_CM},
+	{0x18AA, 0x18AA, lbc_AL},
+	{0x18B0, 0x18F5, lbc_AL},
+	{0x1900, 0x191E, lbc_AL},
+	{0x1920, 0x192B, lbc_CM},
+	{0x1930, 0x193B, lbc_CM},
+	{0x1940, 0x1940, lbc_AL},
+	{0x1944, 0x1945, lbc_EX},
+	{0x1946, 0x194F, lbc_NU},
+	{0x1950, 0x196D, lbc_SA},
+	{0x1970, 0x1974, lbc_SA},
+	{0x1980, 0x19AB, lbc_SA},
+	{0x19B0, 0x19C9, lbc_SA},
+	{0x19D0, 0x19DA, lbc_NU},
+	{0x19DE, 0x19DF, lbc_SA},
+	{0x19E0, 0x1A16, lbc_AL},
+	{0x1A17, 0x1A1B, lbc_CM},
+	{0x1A1E, 0x1A1F, lbc_AL},
+	{0x1A20, 0x1A5E, lbc_SA},
+	{0x1A60, 0x1A7C, lbc_SA},
+	{0x1A7F, 0x1A7F, lbc_CM},
+	{0x1A80, 0x1A89, lbc_NU},
+	{0x1A90, 0x1A99, lbc_NU},
+	{0x1AA0, 0x1AAD, lbc_SA},
+	{0x1AB0, 0x1ADD, lbc_CM},
+	{0x1AE0, 0x1AEA, lbc_CM},
+	{0x1AEB, 0x1AEB, lbc_GL},
+	{0x1B00, 0x1B04, lbc_CM},
+	{0x1B05, 0x1B33, lbc_AK},
+	{0x1B34, 0x1B43, lbc_CM},
+	{0x1B44, 0x1B44, lbc_VI},
+	{0x1B45, 0x1B4C, lbc_AK},
+	{0x1B4E, 0x1B4F, lbc_BA},
+	{0x1B50, 0x1B59, lbc_AS},
+	{0x1B5A, 0x1B5B, lbc_BA},
+	{0x1B5C, 0x1B5C, lbc_ID},
+	{0x1B5D, 0x1B60, lbc_BA},
+	{0x1B61, 0x1B6A, lbc_ID},
+	{0x1B6B, 0x1B73, lbc_CM},
+	{0x1B74, 0x1B7C, lbc_ID},
+	{0x1B7D, 0x1B7F, lbc_BA},
+	{0x1B80, 0x1B82, lbc_CM},
+	{0x1B83, 0x1BA0, lbc_AL},
+	{0x1BA1, 0x1BAD, lbc_CM},
+	{0x1BAE, 0x1BAF, lbc_AL},
+	{0x1BB0, 0x1BB9, lbc_NU},
+	{0x1BBA, 0x1BBF, lbc_AL},
+	{0x1BC0, 0x1BE5, lbc_AS},
+	{0x1BE6, 0x1BF1, lbc_CM},
+	{0x1BF2, 0x1BF3, lbc_VF},
+	{0x1BFC, 0x1C23, lbc_AL},
+	{0x1C24, 0x1C37, lbc_CM},
+	{0x1C3B, 0x1C3F, lbc_BA},
+	{0x1C40, 0x1C49, lbc_NU},
+	{0x1C4D, 0x1C4F, lbc_AL},
+	{0x1C50, 0x1C59, lbc_NU},
+	{0x1C5A, 0x1C7D, lbc_AL},
+	{0x1C7E, 0x1C7F, lbc_BA},
+	{0x1C80, 0x1C8A, lbc_AL},
+	{0x1C90, 0x1CBA, lbc_AL},
+	{0x1CBD, 0x1CC7, lbc_AL},
+	{0x1CD0, 0x1CD2, lbc_CM},
+	{0x1CD3, 0x1CD3, lbc_AL},
+	{0x1CD4, 0x1CE8, lbc_CM},
+	{0x1CE9, 0x1CEC, lbc_AL},
+	{0x1CED, 0x1CED, lbc_CM},
+	{0x1CEE, 0x1CF3, lbc_AL},
+	{0x1CF4, 0x1CF4, lbc_CM},
+	{0x1CF5, 0x1CF6, lbc_AL},
+	{0x1CF7, 0x1CF9, lbc_CM},
+	{0x1CFA, 0x1CFA, lbc_AL},
+	{0x1D00, 0x1DBF, lbc_AL},
+	{0x1DC0, 0x1DCC, lbc_CM},
+	{0x1DCD, 0x1DCD, lbc_GL},
+	{0x1DCE, 0x1DFB, lbc_CM},
+	{0x1DFC, 0x1DFC, lbc_GL},
+	{0x1DFD, 0x1DFF, lbc_CM},
+	{0x1E00, 0x1F15, lbc_AL},
+	{0x1F18, 0x1F1D, lbc_AL},
+	{0x1F20, 0x1F45, lbc_AL},
+	{0x1F48, 0x1F4D, lbc_AL},
+	{0x1F50, 0x1F57, lbc_AL},
+	{0x1F59, 0x1F59, lbc_AL},
+	{0x1F5B, 0x1F5B, lbc_AL},
+	{0x1F5D, 0x1F5D, lbc_AL},
+	{0x1F5F, 0x1F7D, lbc_AL},
+	{0x1F80, 0x1FB4, lbc_AL},
+	{0x1FB6, 0x1FC4, lbc_AL},
+	{0x1FC6, 0x1FD3, lbc_AL},
+	{0x1FD6, 0x1FDB, lbc_AL},
+	{0x1FDD, 0x1FEF, lbc_AL},
+	{0x1FF2, 0x1FF4, lbc_AL},
+	{0x1FF6, 0x1FFC, lbc_AL},
+	{0x1FFD, 0x1FFD, lbc_BB},
+	{0x1FFE, 0x1FFE, lbc_AL},
+	{0x2000, 0x2006, lbc_BA},
+	{0x2007, 0x2007, lbc_GL},
+	{0x2008, 0x200A, lbc_BA},
+	{0x200B, 0x200B, lbc_ZW},
+	{0x200C, 0x200C, lbc_CM},
+	{0x200D, 0x200D, lbc_ZWJ},
+	{0x200E, 0x200F, lbc_CM},
+	{0x2010, 0x2010, lbc_HH},
+	{0x2011, 0x2011, lbc_GL},
+	{0x2012, 0x2013, lbc_HH},
+	{0x2014, 0x2014, lbc_B2},
+	{0x2015, 0x2016, lbc_AI},
+	{0x2017, 0x2017, lbc_AL},
+	{0x2018, 0x2019, lbc_QU},
+	{0x201A, 0x201A, lbc_OP},
+	{0x201B, 0x201D, lbc_QU},
+	{0x201E, 0x201E, lbc_OP},
+	{0x201F, 0x201F, lbc_QU},
+	{0x2020, 0x2021, lbc_AI},
+	{0x2022, 0x2023, lbc_AL},
+	{0x2024, 0x2026, lbc_IN},
+	{0x2027, 0x2027, lbc_BA},
+	{0x2028, 0x2029, lbc_BK},
+	{0x202A, 0x202E, lbc_CM},
+	{0x202F, 0x202F, lbc_GL},
+	{0x2030, 0x2037, lbc_PO},
+	{0x2038, 0x2038, lbc_AL},
+	{0x2039, 0x203A, lbc_QU},
+	{0x203B, 0x203B, lbc_AI},
+	{0x203C, 0x203D, lbc_NS},
+	{0x203E, 0x2043, lbc_AL},
+	{0x2044, 0x2044, lbc_IS},
+	{0x2045, 0x2045, lbc_OP},
+	{0x2046, 0x2046, lbc_CL},
+	{0x2047, 0x2049, lbc_NS},
+	{0x204A, 0x2055, lbc_AL},
+	{0x2056, 0x2056, lbc_BA},
+	{0x2057, 0x2057, lbc_PO},
+	{0x2058, 0x205B, lbc_BA},
+	{0x205C, 0x205C, lbc_AL},
+	{0x205D, 0x205F, lbc_BA},
+	{0x2060, 0x2060, lbc_WJ},
+	{0x2061, 0x2064, lbc_AL},
+	{0x2066, 0x206F, lbc_CM},
+	{0x2070, 0x2071, lbc_AL},
+	{0x2074, 0x2074, lbc_AI},
+	{0x2075, 0x207C, lbc_AL},
+	{0x207D, 0x207D, lbc_OP},
+	{0x207E, 0x207E, lbc_CL},
+	{0x207F, 0x207F, lbc_AI},
+	{0x2080, 0x2080, lbc_AL},
+	{0x2081, 0x2084, lbc_AI},
+	{0x2085, 0x208C, lbc_AL},
+	{0x208D, 0x208D, lbc_OP},
+	{0x208E, 0x208E, lbc_CL},
+	{0x2090, 0x209C, lbc_AL},
+	{0x20A0, 0x20A6, lbc_PR},
+	{0x20A7, 0x20A7, lbc_PO},
+	{0x20A8, 0x20B5, lbc_PR},
+	{0x20B6, 0x20B6, lbc_PO},
+	{0x20B7, 0x20BA, lbc_PR},
+	{0x20BB, 0x20BB, lbc_PO},
+	{0x20BC, 0x20BD, lbc_PR},
+	{0x20BE, 0x20BE, lbc_PO},
+	{0x20BF, 0x20BF, lbc_PR},
+	{0x20C0, 0x20C0, lbc_PO},
+	{0x20C1, 0x20CF, lbc_PR},
+	{0x20D0, 0x20F0, lbc_CM},
+	{0x2100, 0x2102, lbc_AL},
+	{0x2103, 0x2103, lbc_PO},
+	{0x2104, 0x2104, lbc_AL},
+	{0x2105, 0x2105, lbc_AI},
+	{0x2106, 0x2108, lbc_AL},
+	{0x2109, 0x2109, lbc_PO},
+	{0x210A, 0x2112, lbc_AL},
+	{0x2113, 0x2113, lbc_AI},
+	{0x2114, 0x2115, lbc_AL},
+	{0x2116, 0x2116, lbc_PR},
+	{0x2117, 0x2120, lbc_AL},
+	{0x2121, 0x2122, lbc_AI},
+	{0x2123, 0x212A, lbc_AL},
+	{0x212B, 0x212B, lbc_AI},
+	{0x212C, 0x214F, lbc_AL},
+	{0x2150, 0x215E, lbc_AI},
+	{0x215F, 0x215F, lbc_AL},
+	{0x2160, 0x216B, lbc_AI},
+	{0x216C, 0x216F, lbc_AL},
+	{0x2170, 0x2179, lbc_AI},
+	{0x217A, 0x2188, lbc_AL},
+	{0x2189, 0x2189, lbc_AI},
+	{0x218A, 0x218B, lbc_AL},
+	{0x2190, 0x2199, lbc_AI},
+	{0x219A, 0x21D1, lbc_AL},
+	{0x21D2, 0x21D2, lbc_AI},
+	{0x21D3, 0x21D3, lbc_AL},
+	{0x21D4, 0x21D4, lbc_AI},
+	{0x21D5, 0x21FF, lbc_AL},
+	{0x2200, 0x2200, lbc_AI},
+	{0x2201, 0x2201, lbc_AL},
+	{0x2202, 0x2203, lbc_AI},
+	{0x2204, 0x2206, lbc_AL},
+	{0x2207, 0x2208, lbc_AI},
+	{0x2209, 0x220A, lbc_AL},
+	{0x220B, 0x220B, lbc_AI},
+	{0x220C, 0x220E, lbc_AL},
+	{0x220F, 0x220F, lbc_AI},
+	{0x2210, 0x2210, lbc_AL},
+	{0x2211, 0x2211, lbc_AI},
+	{0x2212, 0x2213, lbc_PR},
+	{0x2214, 0x2214, lbc_AL},
+	{0x2215, 0x2215, lbc_AI},
+	{0x2216, 0x2219, lbc_AL},
+	{0x221A, 0x221A, lbc_AI},
+	{0x221B, 0x221C, lbc_AL},
+	{0x221D, 0x2220, lbc_AI},
+	{0x2221, 0x2222, lbc_AL},
+	{0x2223, 0x2223, lbc_AI},
+	{0x2224, 0x2224, lbc_AL},
+	{0x2225, 0x2225, lbc_AI},
+	{0x2226, 0x2226, lbc_AL},
+	{0x2227, 0x222C, lbc_AI},
+	{0x222D, 0x222D, lbc_AL},
+	{0x222E, 0x222E, lbc_AI},
+	{0x222F, 0x2233, lbc_AL},
+	{0x2234, 0x2237, lbc_AI},
+	{0x2238, 0x223B, lbc_AL},
+	{0x223C, 0x223D, lbc_AI},
+	{0x223E, 0x2247, lbc_AL},
+	{0x2248, 0x2248, lbc_AI},
+	{0x2249, 0x224B, lbc_AL},
+	{0x224C, 0x224C, lbc_AI},
+	{0x224D, 0x2251, lbc_AL},
+	{0x2252, 0x2252, lbc_AI},
+	{0x2253, 0x225F, lbc_AL},
+	{0x2260, 0x2261, lbc_AI},
+	{0x2262, 0x2263, lbc_AL},
+	{0x2264, 0x2267, lbc_AI},
+	{0x2268, 0x2269, lbc_AL},
+	{0x226A, 0x226B, lbc_AI},
+	{0x226C, 0x226D, lbc_AL},
+	{0x226E, 0x226F, lbc_AI},
+	{0x2270, 0x2281, lbc_AL},
+	{0x2282, 0x2283, lbc_AI},
+	{0x2284, 0x2285, lbc_AL},
+	{0x2286, 0x2287, lbc_AI},
+	{0x2288, 0x2294, lbc_AL},
+	{0x2295, 0x2295, lbc_AI},
+	{0x2296, 0x2298, lbc_AL},
+	{0x2299, 0x2299, lbc_AI},
+	{0x229A, 0x22A4, lbc_AL},
+	{0x22A5, 0x22A5, lbc_AI},
+	{0x22A6, 0x22BE, lbc_AL},
+	{0x22BF, 0x22BF, lbc_AI},
+	{0x22C0, 0x22EE, lbc_AL},
+	{0x22EF, 0x22EF, lbc_IN},
+	{0x22F0, 0x2307, lbc_AL},
+	{0x2308, 0x2308, lbc_OP},
+	{0x2309, 0x2309, lbc_CL},
+	{0x230A, 0x230A, lbc_OP},
+	{0x230B, 0x230B, lbc_CL},
+	{0x230C, 0x2311, lbc_AL},
+	{0x2312, 0x2312, lbc_AI},
+	{0x2313, 0x2319, lbc_AL},
+	{0x231A, 0x231B, lbc_ID},
+	{0x231C, 0x2328, lbc_AL},
+	{0x2329, 0x2329, lbc_OP},
+	{0x232A, 0x232A, lbc_CL},
+	{0x232B, 0x23EF, lbc_AL},
+	{0x23F0, 0x23F3, lbc_ID},
+	{0x23F4, 0x2429, lbc_AL},
+	{0x2440, 0x244A, lbc_AL},
+	{0x2460, 0x24FE, lbc_AI},
+	{0x24FF, 0x24FF, lbc_AL},
+	{0x2500, 0x254B, lbc_AI},
+	{0x254C, 0x254F, lbc_AL},
+	{0x2550, 0x2574, lbc_AI},
+	{0x2575, 0x257F, lbc_AL},
+	{0x2580, 0x258F, lbc_AI},
+	{0x2590, 0x2591, lbc_AL},
+	{0x2592, 0x2595, lbc_AI},
+	{0x2596, 0x259F, lbc_AL},
+	{0x25A0, 0x25A1, lbc_AI},
+	{0x25A2, 0x25A2, lbc_AL},
+	{0x25A3, 0x25A9, lbc_AI},
+	{0x25AA, 0x25B1, lbc_AL},
+	{0x25B2, 0x25B3, lbc_AI},
+	{0x25B4, 0x25B5, lbc_AL},
+	{0x25B6, 0x25B7, lbc_AI},
+	{0x25B8, 0x25BB, lbc_AL},
+	{0x25BC, 0x25BD, lbc_AI},
+	{0x25BE, 0x25BF, lbc_AL},
+	{0x25C0, 0x25C1, lbc_AI},
+	{0x25C2, 0x25C5, lbc_AL},
+	{0x25C6, 0x25C8, lbc_AI},
+	{0x25C9, 0x25CA, lbc_AL},
+	{0x25CB, 0x25CB, lbc_AI},
+	{0x25CC, 0x25CD, lbc_AL},
+	{0x25CE, 0x25D1, lbc_AI},
+	{0x25D2, 0x25E1, lbc_AL},
+	{0x25E2, 0x25E5, lbc_AI},
+	{0x25E6, 0x25EE, lbc_AL},
+	{0x25EF, 0x25EF, lbc_AI},
+	{0x25F0, 0x25FF, lbc_AL},
+	{0x2600, 0x2603, lbc_ID},
+	{0x2604, 0x2604, lbc_AL},
+	{0x2605, 0x2606, lbc_AI},
+	{0x2607, 0x2608, lbc_AL},
+	{0x2609, 0x2609, lbc_AI},
+	{0x260A, 0x260D, lbc_AL},
+	{0x260E, 0x260F, lbc_AI},
+	{0x2610, 0x2613, lbc_AL},
+	{0x2614, 0x2615, lbc_ID},
+	{0x2616, 0x2617, lbc_AI},
+	{0x2618, 0x2618, lbc_ID},
+	{0x2619, 0x2619, lbc_AL},
+	{0x261A, 0x261C, lbc_ID},
+	{0x261D, 0x261D, lbc_EB},
+	{0x261E, 0x261F, lbc_ID},
+	{0x2620, 0x2638, lbc_AL},
+	{0x2639, 0x263B, lbc_ID},
+	{0x263C, 0x263F, lbc_AL},
+	{0x2640, 0x2640, lbc_AI},
+	{0x2641, 0x2641, lbc_AL},
+	{0x2642, 0x2642, lbc_AI},
+	{0x2643, 0x265F, lbc_AL},
+	{0x2660, 0x2661, lbc_AI},
+	{0x2662, 0x2662, lbc_AL},
+	{0x2663, 0x2665, lbc_AI},
+	{0x2666, 0x2666, lbc_AL},
+	{0x2667, 0x2667, lbc_AI},
+	{0x2668, 0x2668, lbc_ID},
+	{0x2669, 0x266A, lbc_AI},
+	{0x266B, 0x266B, lbc_AL},
+	{0x266C, 0x266D, lbc_AI},
+	{0x266E, 0x266E, lbc_AL},
+	{0x266F, 0x266F, lbc_AI},
+	{0x2670, 0x267E, lbc_AL},
+	{0x267F, 0x267F, lbc_ID},
+	{0x2680, 0x269D, lbc_AL},
+	{0x269E, 0x269F, lbc_AI},
+	{0x26A0, 0x26BC, lbc_AL},
+	{0x26BD, 0x26C8, lbc_ID},
+	{0x26C9, 0x26CC, lbc_AI},
+	{0x26CD, 0x26CD, lbc_ID},
+	{0x26CE, 0x26CE, lbc_AL},
+	{0x26CF, 0x26D1, lbc_ID},
+	{0x26D2, 0x26D2, lbc_AI},
+	{0x26D3, 0x26D4, lbc_ID},
+	{0x26D5, 0x26D7, lbc_AI},
+	{0x26D8, 0x26D9, lbc_ID},
+	{0x26DA, 0x26DB, lbc_AI},
+	{0x26DC, 0x26DC, lbc_ID},
+	{0x26DD, 0x26DE, lbc_AI},
+	{0x26DF, 0x26E1, lbc_ID},
+	{0x26E2, 0x26E2, lbc_AL},
+	{0x26E3, 0x26E3, lbc_AI},
+	{0x26E4, 0x26E7, lbc_AL},
+	{0x26E8, 0x26E9, lbc_AI},
+	{0x26EA, 0x26EA, lbc_ID},
+	{0x26EB, 0x26F0, lbc_AI},
+	{0x26F1, 0x26F5, lbc_ID},
+	{0x26F6, 0x26F6, lbc_AI},
+	{0x26F7, 0x26F8, lbc_ID},
+	{0x26F9, 0x26F9, lbc_EB},
+	{0x26FA, 0x26FA, lbc_ID},
+	{0x26FB, 0x26FC, lbc_AI},
+	{0x26FD, 0x2704, lbc_ID},
+	{0x2705, 0x2707, lbc_AL},
+	{0x2708, 0x2709, lbc_ID},
+	{0x270A, 0x270D, lbc_EB},
+	{0x270E, 0x2756, lbc_AL},
+	{0x2757, 0x2757, lbc_AI},
+	{0x2758, 0x275A, lbc_AL},
+	{0x275B, 0x2760, lbc_QU},
+	{0x2761, 0x2761, lbc_AL},
+	{0x2762, 0x2763, lbc_EX},
+	{0x2764, 0x2764, lbc_ID},
+	{0x2765, 0x2767, lbc_AL},
+	{0x2768, 0x2768, lbc_OP},
+	{0x2769, 0x2769, lbc_CL},
+	{0x276A, 0x276A, lbc_OP},
+	{0x276B, 0x276B, lbc_CL},
+	{0x276C, 0x276C, lbc_OP},
+	{0x276D, 0x276D, lbc_CL},
+	{0x276E, 0x276E, lbc_OP},
+	{0x276F, 0x276F, lbc_CL},
+	{0x2770, 0x2770, lbc_OP},
+	{0x2771, 0x2771, lbc_CL},
+	{0x2772, 0x2772, lbc_OP},
+	{0x2773, 0x2773, lbc_CL},
+	{0x2774, 0x2774, lbc_OP},
+	{0x2775, 0x2775, lbc_CL},
+	{0x2776, 0x2793, lbc_AI},
+	{0x2794, 0x27C4, lbc_AL},
+	{0x27C5, 0x27C5, lbc_OP},
+	{0x27C6, 0x27C6, lbc_CL},
+	{0x27C7, 0x27E5, lbc_AL},
+	{0x27E6, 0x27E6, lbc_OP},
+	{0x27E7, 0x27E7, lbc_CL},
+	{0x27E8, 0x27E8, lbc_OP},
+	{0x27E9, 0x27E9, lbc_CL},
+	{0x27EA, 0x27EA, lbc_OP},
+	{0x27EB, 0x27EB, lbc_CL},
+	{0x27EC, 0x27EC, lbc_OP},
+	{0x27ED, 0x27ED, lbc_CL},
+	{0x27EE, 0x27EE, lbc_OP},
+	{0x27EF, 0x27EF, lbc_CL},
+	{0x27F0, 0x27FF, lbc_AL},
+	{0x2800, 0x2800, lbc_BA},
+	{0x2801, 0x2982, lbc_AL},
+	{0x2983, 0x2983, lbc_OP},
+	{0x2984, 0x2984, lbc_CL},
+	{0x2985, 0x2985, lbc_OP},
+	{0x2986, 0x2986, lbc_CL},
+	{0x2987, 0x2987, lbc_OP},
+	{0x2988, 0x2988, lbc_CL},
+	{0x2989, 0x2989, lbc_OP},
+	{0x298A, 0x298A, lbc_CL},
+	{0x298B, 0x298B, lbc_OP},
+	{0x298C, 0x298C, lbc_CL},
+	{0x298D, 0x298D, lbc_OP},
+	{0x298E, 0x298E, lbc_CL},
+	{0x298F, 0x298F, lbc_OP},
+	{0x2990, 0x2990, lbc_CL},
+	{0x2991, 0x2991, lbc_OP},
+	{0x2992, 0x2992, lbc_CL},
+	{0x2993, 0x2993, lbc_OP},
+	{0x2994, 0x2994, lbc_CL},
+	{0x2995, 0x2995, lbc_OP},
+	{0x2996, 0x2996, lbc_CL},
+	{0x2997, 0x2997, lbc_OP},
+	{0x2998, 0x2998, lbc_CL},
+	{0x2999, 0x29D7, lbc_AL},
+	{0x29D8, 0x29D8, lbc_OP},
+	{0x29D9, 0x29D9, lbc_CL},
+	{0x29DA, 0x29DA, lbc_OP},
+	{0x29DB, 0x29DB, lbc_CL},
+	{0x29DC, 0x29FB, lbc_AL},
+	{0x29FC, 0x29FC, lbc_OP},
+	{0x29FD, 0x29FD, lbc_CL},
+	{0x29FE, 0x2B54, lbc_AL},
+	{0x2B55, 0x2B59, lbc_AI},
+	{0x2B5A, 0x2B73, lbc_AL},
+	{0x2B76, 0x2CEE, lbc_AL},
+	{0x2CEF, 0x2CF1, lbc_CM},
+	{0x2CF2, 0x2CF3, lbc_AL},
+	{0x2CF9, 0x2CF9, lbc_EX},
+	{0x2CFA, 0x2CFC, lbc_BA},
+	{0x2CFD, 0x2CFD, lbc_AL},
+	{0x2CFE, 0x2CFE, lbc_EX},
+	{0x2CFF, 0x2CFF, lbc_BA},
+	{0x2D00, 0x2D25, lbc_AL},
+	{0x2D27, 0x2D27, lbc_AL},
+	{0x2D2D, 0x2D2D, lbc_AL},
+	{0x2D30, 0x2D67, lbc_AL},
+	{0x2D6F, 0x2D6F, lbc_AL},
+	{0x2D70, 0x2D70, lbc_BA},
+	{0x2D7F, 0x2D7F, lbc_CM},
+	{0x2D80, 0x2D96, lbc_AL},
+	{0x2DA0, 0x2DA6, lbc_AL},
+	{0x2DA8, 0x2DAE, lbc_AL},
+	{0x2DB0, 0x2DB6, lbc_AL},
+	{0x2DB8, 0x2DBE, lbc_AL},
+	{0x2DC0, 0x2DC6, lbc_AL},
+	{0x2DC8, 0x2DCE, lbc_AL},
+	{0x2DD0, 0x2DD6, lbc_AL},
+	{0x2DD8, 0x2DDE, lbc_AL},
+	{0x2DE0, 0x2DFF, lbc_CM},
+	{0x2E00, 0x2E0D, lbc_QU},
+	{0x2E0E, 0x2E15, lbc_BA},
+	{0x2E16, 0x2E16, lbc_AL},
+	{0x2E17, 0x2E17, lbc_HH},
+	{0x2E18, 0x2E18, lbc_OP},
+	{0x2E19, 0x2E19, lbc_BA},
+	{0x2E1A, 0x2E1B, lbc_AL},
+	{0x2E1C, 0x2E1D, lbc_QU},
+	{0x2E1E, 0x2E1F, lbc_AL},
+	{0x2E20, 0x2E21, lbc_QU},
+	{0x2E22, 0x2E22, lbc_OP},
+	{0x2E23, 0x2E23, lbc_CL},
+	{0x2E24, 0x2E24, lbc_OP},
+	{0x2E25, 0x2E25, lbc_CL},
+	{0x2E26, 0x2E26, lbc_OP},
+	{0x2E27, 0x2E27, lbc_CL},
+	{0x2E28, 0x2E28, lbc_OP},
+	{0x2E29, 0x2E29, lbc_CL},
+	{0x2E2A, 0x2E2D, lbc_BA},
+	{0x2E2E, 0x2E2E, lbc_EX},
+	{0x2E2F, 0x2E2F, lbc_AL},
+	{0x2E30, 0x2E31, lbc_BA},
+	{0x2E32, 0x2E32, lbc_AL},
+	{0x2E33, 0x2E34, lbc_BA},
+	{0x2E35, 0x2E39, lbc_AL},
+	{0x2E3A, 0x2E3B, lbc_B2},
+	{0x2E3C, 0x2E3E, lbc_BA},
+	{0x2E3F, 0x2E3F, lbc_AL},
+	{0x2E40, 0x2E40, lbc_HH},
+	{0x2E41, 0x2E41, lbc_BA},
+	{0x2E42, 0x2E42, lbc_OP},
+	{0x2E43, 0x2E4A, lbc_BA},
+	{0x2E4B, 0x2E4B, lbc_AL},
+	{0x2E4C, 0x2E4C, lbc_BA},
+	{0x2E4D, 0x2E4D, lbc_AL},
+	{0x2E4E, 0x2E4F, lbc_BA},
+	{0x2E50, 0x2E52, lbc_AL},
+	{0x2E53, 0x2E54, lbc_EX},
+	{0x2E55, 0x2E55, lbc_OP},
+	{0x2E56, 0x2E56, lbc_CP},
+	{0x2E57, 0x2E57, lbc_OP},
+	{0x2E58, 0x2E58, lbc_CP},
+	{0x2E59, 0x2E59, lbc_OP},
+	{0x2E5A, 0x2E5A, lbc_CP},
+	{0x2E5B, 0x2E5B, lbc_OP},
+	{0x2E5C, 0x2E5C, lbc_CP},
+	{0x2E5D, 0x2E5D, lbc_HH},
+	{0x2E80, 0x2E99, lbc_ID},
+	{0x2E9B, 0x2EF3, lbc_ID},
+	{0x2F00, 0x2FD5, lbc_ID},
+	{0x2FF0, 0x2FFF, lbc_ID},
+	{0x3000, 0x3000, lbc_BA},
+	{0x3001, 0x3002, lbc_CL},
+	{0x3003, 0x3004, lbc_ID},
+	{0x3005, 0x3005, lbc_NS},
+	{0x3006, 0x3007, lbc_ID},
+	{0x3008, 0x3008, lbc_OP},
+	{0x3009, 0x3009, lbc_CL},
+	{0x300A, 0x300A, lbc_OP},
+	{0x300B, 0x300B, lbc_CL},
+	{0x300C, 0x300C, lbc_OP},
+	{0x300D, 0x300D, lbc_CL},
+	{0x300E, 0x300E, lbc_OP},
+	{0x300F, 0x300F, lbc_CL},
+	{0x3010, 0x3010, lbc_OP},
+	{0x3011, 0x3011, lbc_CL},
+	{0x3012, 0x3013, lbc_ID},
+	{0x3014, 0x3014, lbc_OP},
+	{0x3015, 0x3015, lbc_CL},
+	{0x3016, 0x3016, lbc_OP},
+	{0x3017, 0x3017, lbc_CL},
+	{0x3018, 0x3018, lbc_OP},
+	{0x3019, 0x3019, lbc_CL},
+	{0x301A, 0x301A, lbc_OP},
+	{0x301B, 0x301B, lbc_CL},
+	{0x301C, 0x301C, lbc_NS},
+	{0x301D, 0x301D, lbc_OP},
+	{0x301E, 0x301F, lbc_CL},
+	{0x3020, 0x3029, lbc_ID},
+	{0x302A, 0x302F, lbc_CM},
+	{0x3030, 0x3034, lbc_ID},
+	{0x3035, 0x3035, lbc_CM},
+	{0x3036, 0x303A, lbc_ID},
+	{0x303B, 0x303C, lbc_NS},
+	{0x303D, 0x303F, lbc_ID},
+	{0x3041, 0x3041, lbc_CJ},
+	{0x3042, 0x3042, lbc_ID},
+	{0x3043, 0x3043, lbc_CJ},
+	{0x3044, 0x3044, lbc_ID},
+	{0x3045, 0x3045, lbc_CJ},
+	{0x3046, 0x3046, lbc_ID},
+	{0x3047, 0x3047, lbc_CJ},
+	{0x3048, 0x3048, lbc_ID},
+	{0x3049, 0x3049, lbc_CJ},
+	{0x304A, 0x3062, lbc_ID},
+	{0x3063, 0x3063, lbc_CJ},
+	{0x3064, 0x3082, lbc_ID},
+	{0x3083, 0x3083, lbc_CJ},
+	{0x3084, 0x3084, lbc_ID},
+	{0x3085, 0x3085, lbc_CJ},
+	{0x3086, 0x3086, lbc_ID},
+	{0x3087, 0x3087, lbc_CJ},
+	{0x3088, 0x308D, lbc_ID},
+	{0x308E, 0x308E, lbc_CJ},
+	{0x308F, 0x3094, lbc_ID},
+	{0x3095, 0x3096, lbc_CJ},
+	{0x3099, 0x309A, lbc_CM},
+	{0x309B, 0x309E, lbc_NS},
+	{0x309F, 0x309F, lbc_ID},
+	{0x30A0, 0x30A0, lbc_NS},
+	{0x30A1, 0x30A1, lbc_CJ},
+	{0x30A2, 0x30A2, lbc_ID},
+	{0x30A3, 0x30A3, lbc_CJ},
+	{0x30A4, 0x30A4, lbc_ID},
+	{0x30A5, 0x30A5, lbc_CJ},
+	{0x30A6, 0x30A6, lbc_ID},
+	{0x30A7, 0x30A7, lbc_CJ},
+	{0x30A8, 0x30A8, lbc_ID},
+	{0x30A9, 0x30A9, lbc_CJ},
+	{0x30AA, 0x30C2, lbc_ID},
+	{0x30C3, 0x30C3, lbc_CJ},
+	{0x30C4, 0x30E2, lbc_ID},
+	{0x30E3, 0x30E3, lbc_CJ},
+	{0x30E4, 0x30E4, lbc_ID},
+	{0x30E5, 0x30E5, lbc_CJ},
+	{0x30E6, 0x30E6, lbc_ID},
+	{0x30E7, 0x30E7, lbc_CJ},
+	{0x30E8, 0x30ED, lbc_ID},
+	{0x30EE, 0x30EE, lbc_CJ},
+	{0x30EF, 0x30F4, lbc_ID},
+	{0x30F5, 0x30F6, lbc_CJ},
+	{0x30F7, 0x30FA, lbc_ID},
+	{0x30FB, 0x30FB, lbc_NS},
+	{0x30FC, 0x30FC, lbc_CJ},
+	{0x30FD, 0x30FE, lbc_NS},
+	{0x30FF, 0x30FF, lbc_ID},
+	{0x3105, 0x312F, lbc_ID},
+	{0x3131, 0x318E, lbc_ID},
+	{0x3190, 0x31E5, lbc_ID},
+	{0x31EF, 0x31EF, lbc_ID},
+	{0x31F0, 0x31FF, lbc_CJ},
+	{0x3200, 0x321E, lbc_ID},
+	{0x3220, 0x3247, lbc_ID},
+	{0x3248, 0x324F, lbc_AI},
+	{0x3250, 0x4DBF, lbc_ID},
+	{0x4DC0, 0x4DFF, lbc_AL},
+	{0x4E00, 0xA014, lbc_ID},
+	{0xA015, 0xA015, lbc_NS},
+	{0xA016, 0xA48C, lbc_ID},
+	{0xA490, 0xA4C6, lbc_ID},
+	{0xA4D0, 0xA4FD, lbc_AL},
+	{0xA4FE, 0xA4FF, lbc_BA},
+	{0xA500, 0xA60C, lbc_AL},
+	{0xA60D, 0xA60D, lbc_BA},
+	{0xA60E, 0xA60E, lbc_EX},
+	{0xA60F, 0xA60F, lbc_BA},
+	{0xA610, 0xA61F, lbc_AL},
+	{0xA620, 0xA629, lbc_NU},
+	{0xA62A, 0xA62B, lbc_AL},
+	{0xA640, 0xA66E, lbc_AL},
+	{0xA66F, 0xA672, lbc_CM},
+	{0xA673, 0xA673, lbc_AL},
+	{0xA674, 0xA67D, lbc_CM},
+	{0xA67E, 0xA69D, lbc_AL},
+	{0xA69E, 0xA69F, lbc_CM},
+	{0xA6A0, 0xA6EF, lbc_AL},
+	{0xA6F0, 0xA6F1, lbc_CM},
+	{0xA6F2, 0xA6F2, lbc_AL},
+	{0xA6F3, 0xA6F7, lbc_BA},
+	{0xA700, 0xA7DC, lbc_AL},
+	{0xA7F1, 0xA801, lbc_AL},
+	{0xA802, 0xA802, lbc_CM},
+	{0xA803, 0xA805, lbc_AL},
+	{0xA806, 0xA806, lbc_CM},
+	{0xA807, 0xA80A, lbc_AL},
+	{0xA80B, 0xA80B, lbc_CM},
+	{0xA80C, 0xA822, lbc_AL},
+	{0xA823, 0xA827, lbc_CM},
+	{0xA828, 0xA82B, lbc_AL},
+	{0xA82C, 0xA82C, lbc_CM},
+	{0xA830, 0xA837, lbc_AL},
+	{0xA838, 0xA838, lbc_PO},
+	{0xA839, 0xA839, lbc_AL},
+	{0xA840, 0xA873, lbc_AL},
+	{0xA874, 0xA875, lbc_BB},
+	{0xA876, 0xA877, lbc_EX},
+	{0xA880, 0xA881, lbc_CM},
+	{0xA882, 0xA8B3, lbc_AL},
+	{0xA8B4, 0xA8C5, lbc_CM},
+	{0xA8CE, 0xA8CF, lbc_BA},
+	{0xA8D0, 0xA8D9, lbc_NU},
+	{0xA8E0, 0xA8F1, lbc_CM},
+	{0xA8F2, 0xA8FB, lbc_AL},
+	{0xA8FC, 0xA8FC, lbc_BB},
+	{0xA8FD, 0xA8FE, lbc_AL},
+	{0xA8FF, 0xA8FF, lbc_CM},
+	{0xA900, 0xA909, lbc_NU},
+	{0xA90A, 0xA925, lbc_AL},
+	{0xA926, 0xA92D, lbc_CM},
+	{0xA92E, 0xA92F, lbc_BA},
+	{0xA930, 0xA946, lbc_AL},
+	{0xA947, 0xA953, lbc_CM},
+	{0xA95F, 0xA95F, lbc_AL},
+	{0xA960, 0xA97C, lbc_JL},
+	{0xA980, 0xA983, lbc_CM},
+	{0xA984, 0xA9B2, lbc_AK},
+	{0xA9B3, 0xA9BF, lbc_CM},
+	{0xA9C0, 0xA9C0, lbc_VI},
+	{0xA9C1, 0xA9C6, lbc_ID},
+	{0xA9C7, 0xA9C9, lbc_BA},
+	{0xA9CA, 0xA9CD, lbc_ID},
+	{0xA9CF, 0xA9CF, lbc_BA},
+	{0xA9D0, 0xA9D9, lbc_AS},
+	{0xA9DE, 0xA9DF, lbc_ID},
+	{0xA9E0, 0xA9EF, lbc_SA},
+	{0xA9F0, 0xA9F9, lbc_NU},
+	{0xA9FA, 0xA9FE, lbc_SA},
+	{0xAA00, 0xAA28, lbc_AS},
+	{0xAA29, 0xAA36, lbc_CM},
+	{0xAA40, 0xAA42, lbc_BA},
+	{0xAA43, 0xAA43, lbc_CM},
+	{0xAA44, 0xAA4B, lbc_BA},
+	{0xAA4C, 0xAA4D, lbc_CM},
+	{0xAA50, 0xAA59, lbc_AS},
+	{0xAA5C, 0xAA5C, lbc_ID},
+	{0xAA5D, 0xAA5F, lbc_BA},
+	{0xAA60, 0xAAC2, lbc_SA},
+	{0xAADB, 0xAADF, lbc_SA},
+	{0xAAE0, 0xAAEA, lbc_AL},
+	{0xAAEB, 0xAAEF, lbc_CM},
+	{0xAAF0, 0xAAF1, lbc_BA},
+	{0xAAF2, 0xAAF4, lbc_AL},
+	{0xAAF5, 0xAAF6, lbc_CM},
+	{0xAB01, 0xAB06, lbc_AL},
+	{0xAB09, 0xAB0E, lbc_AL},
+	{0xAB11, 0xAB16, lbc_AL},
+	{0xAB20, 0xAB26, lbc_AL},
+	{0xAB28, 0xAB2E, lbc_AL},
+	{0xAB30, 0xAB6B, lbc_AL},
+	{0xAB70, 0xABE2, lbc_AL},
+	{0xABE3, 0xABEA, lbc_CM},
+	{0xABEB, 0xABEB, lbc_BA},
+	{0xABEC, 0xABED, lbc_CM},
+	{0xABF0, 0xABF9, lbc_NU},
+	{0xAC00, 0xAC00, lbc_H2},
+	{0xAC01, 0xAC1B, lbc_H3},
+	{0xAC1C, 0xAC1C, lbc_H2},
+	{0xAC1D, 0xAC37, lbc_H3},
+	{0xAC38, 0xAC38, lbc_H2},
+	{0xAC39, 0xAC53, lbc_H3},
+	{0xAC54, 0xAC54, lbc_H2},
+	{0xAC55, 0xAC6F, lbc_H3},
+	{0xAC70, 0xAC70, lbc_H2},
+	{0xAC71, 0xAC8B, lbc_H3},
+	{0xAC8C, 0xAC8C, lbc_H2},
+	{0xAC8D, 0xACA7, lbc_H3},
+	{0xACA8, 0xACA8, lbc_H2},
+	{0xACA9, 0xACC3, lbc_H3},
+	{0xACC4, 0xACC4, lbc_H2},
+	{0xACC5, 0xACDF, lbc_H3},
+	{0xACE0, 0xACE0, lbc_H2},
+	{0xACE1, 0xACFB, lbc_H3},
+	{0xACFC, 0xACFC, lbc_H2},
+	{0xACFD, 0xAD17, lbc_H3},
+	{0xAD18, 0xAD18, lbc_H2},
+	{0xAD19, 0xAD33, lbc_H3},
+	{0xAD34, 0xAD34, lbc_H2},
+	{0xAD35, 0xAD4F, lbc_H3},
+	{0xAD50, 0xAD50, lbc_H2},
+	{0xAD51, 0xAD6B, lbc_H3},
+	{0xAD6C, 0xAD6C, lbc_H2},
+	{0xAD6D, 0xAD87, lbc_H3},
+	{0xAD88, 0xAD88, lbc_H2},
+	{0xAD89, 0xADA3, lbc_H3},
+	{0xADA4, 0xADA4, lbc_H2},
+	{0xADA5, 0xADBF, lbc_H3},
+	{0xADC0, 0xADC0, lbc_H2},
+	{0xADC1, 0xADDB, lbc_H3},
+	{0xADDC, 0xADDC, lbc_H2},
+	{0xADDD, 0xADF7, lbc_H3},
+	{0xADF8, 0xADF8, lbc_H2},
+	{0xADF9, 0xAE13, lbc_H3},
+	{0xAE14, 0xAE14, lbc_H2},
+	{0xAE15, 0xAE2F, lbc_H3},
+	{0xAE30, 0xAE30, lbc_H2},
+	{0xAE31, 0xAE4B, lbc_H3},
+	{0xAE4C, 0xAE4C, lbc_H2},
+	{0xAE4D, 0xAE67, lbc_H3},
+	{0xAE68, 0xAE68, lbc_H2},
+	{0xAE69, 0xAE83, lbc_H3},
+	{0xAE84, 0xAE84, lbc_H2},
+	{0xAE85, 0xAE9F, lbc_H3},
+	{0xAEA0, 0xAEA0, lbc_H2},
+	{0xAEA1, 0xAEBB, lbc_H3},
+	{0xAEBC, 0xAEBC, lbc_H2},
+	{0xAEBD, 0xAED7, lbc_H3},
+	{0xAED8, 0xAED8, lbc_H2},
+	{0xAED9, 0xAEF3, lbc_H3},
+	{0xAEF4, 0xAEF4, lbc_H2},
+	{0xAEF5, 0xAF0F, lbc_H3},
+	{0xAF10, 0xAF10, lbc_H2},
+	{0xAF11, 0xAF2B, lbc_H3},
+	{0xAF2C, 0xAF2C, lbc_H2},
+	{0xAF2D, 0xAF47, lbc_H3},
+	{0xAF48, 0xAF48, lbc_H2},
+	{0xAF49, 0xAF63, lbc_H3},
+	{0xAF64, 0xAF64, lbc_H2},
+	{0xAF65, 0xAF7F, lbc_H3},
+	{0xAF80, 0xAF80, lbc_H2},
+	{0xAF81, 0xAF9B, lbc_H3},
+	{0xAF9C, 0xAF9C, lbc_H2},
+	{0xAF9D, 0xAFB7, lbc_H3},
+	{0xAFB8, 0xAFB8, lbc_H2},
+	{0xAFB9, 0xAFD3, lbc_H3},
+	{0xAFD4, 0xAFD4, lbc_H2},
+	{0xAFD5, 0xAFEF, lbc_H3},
+	{0xAFF0, 0xAFF0, lbc_H2},
+	{0xAFF1, 0xB00B, lbc_H3},
+	{0xB00C, 0xB00C, lbc_H2},
+	{0xB00D, 0xB027, lbc_H3},
+	{0xB028, 0xB028, lbc_H2},
+	{0xB029, 0xB043, lbc_H3},
+	{0xB044, 0xB044, lbc_H2},
+	{0xB045, 0xB05F, lbc_H3},
+	{0xB060, 0xB060, lbc_H2},
+	{0xB061, 0xB07B, lbc_H3},
+	{0xB07C, 0xB07C, lbc_H2},
+	{0xB07D, 0xB097, lbc_H3},
+	{0xB098, 0xB098, lbc_H2},
+	{0xB099, 0xB0B3, lbc_H3},
+	{0xB0B4, 0xB0B4, lbc_H2},
+	{0xB0B5, 0xB0CF, lbc_H3},
+	{0xB0D0, 0xB0D0, lbc_H2},
+	{0xB0D1, 0xB0EB, lbc_H3},
+	{0xB0EC, 0xB0EC, lbc_H2},
+	{0xB0ED, 0xB107, lbc_H3},
+	{0xB108, 0xB108, lbc_H2},
+	{0xB109, 0xB123, lbc_H3},
+	{0xB124, 0xB124, lbc_H2},
+	{0xB125, 0xB13F, lbc_H3},
+	{0xB140, 0xB140, lbc_H2},
+	{0xB141, 0xB15B, lbc_H3},
+	{0xB15C, 0xB15C, lbc_H2},
+	{0xB15D, 0xB177, lbc_H3},
+	{0xB178, 0xB178, lbc_H2},
+	{0xB179, 0xB193, lbc_H3},
+	{0xB194, 0xB194, lbc_H2},
+	{0xB195, 0xB1AF, lbc_H3},
+	{0xB1B0, 0xB1B0, lbc_H2},
+	{0xB1B1, 0xB1CB, lbc_H3},
+	{0xB1CC, 0xB1CC, lbc_H2},
+	{0xB1CD, 0xB1E7, lbc_H3},
+	{0xB1E8, 0xB1E8, lbc_H2},
+	{0xB1E9, 0xB203, lbc_H3},
+	{0xB204, 0xB204, lbc_H2},
+	{0xB205, 0xB21F, lbc_H3},
+	{0xB220, 0xB220, lbc_H2},
+	{0xB221, 0xB23B, lbc_H3},
+	{0xB23C, 0xB23C, lbc_H2},
+	{0xB23D, 0xB257, lbc_H3},
+	{0xB258, 0xB258, lbc_H2},
+	{0xB259, 0xB273, lbc_H3},
+	{0xB274, 0xB274, lbc_H2},
+	{0xB275, 0xB28F, lbc_H3},
+	{0xB290, 0xB290, lbc_H2},
+	{0xB291, 0xB2AB, lbc_H3},
+	{0xB2AC, 0xB2AC, lbc_H2},
+	{0xB2AD, 0xB2C7, lbc_H3},
+	{0xB2C8, 0xB2C8, lbc_H2},
+	{0xB2C9, 0xB2E3, lbc_H3},
+	{0xB2E4, 0xB2E4, lbc_H2},
+	{0xB2E5, 0xB2FF, lbc_H3},
+	{0xB300, 0xB300, lbc_H2},
+	{0xB301, 0xB31B, lbc_H3},
+	{0xB31C, 0xB31C, lbc_H2},
+	{0xB31D, 0xB337, lbc_H3},
+	{0xB338, 0xB338, lbc_H2},
+	{0xB339, 0xB353, lbc_H3},
+	{0xB354, 0xB354, lbc_H2},
+	{0xB355, 0xB36F, lbc_H3},
+	{0xB370, 0xB370, lbc_H2},
+	{0xB371, 0xB38B, lbc_H3},
+	{0xB38C, 0xB38C, lbc_H2},
+	{0xB38D, 0xB3A7, lbc_H3},
+	{0xB3A8, 0xB3A8, lbc_H2},
+	{0xB3A9, 0xB3C3, lbc_H3},
+	{0xB3C4, 0xB3C4, lbc_H2},
+	{0xB3C5, 0xB3DF, lbc_H3},
+	{0xB3E0, 0xB3E0, lbc_H2},
+	{0xB3E1, 0xB3FB, lbc_H3},
+	{0xB3FC, 0xB3FC, lbc_H2},
+	{0xB3FD, 0xB417, lbc_H3},
+	{0xB418, 0xB418, lbc_H2},
+	{0xB419, 0xB433, lbc_H3},
+	{0xB434, 0xB434, lbc_H2},
+	{0xB435, 0xB44F, lbc_H3},
+	{0xB450, 0xB450, lbc_H2},
+	{0xB451, 0xB46B, lbc_H3},
+	{0xB46C, 0xB46C, lbc_H2},
+	{0xB46D, 0xB487, lbc_H3},
+	{0xB488, 0xB488, lbc_H2},
+	{0xB489, 0xB4A3, lbc_H3},
+	{0xB4A4, 0xB4A4, lbc_H2},
+	{0xB4A5, 0xB4BF, lbc_H3},
+	{0xB4C0, 0xB4C0, lbc_H2},
+	{0xB4C1, 0xB4DB, lbc_H3},
+	{0xB4DC, 0xB4DC, lbc_H2},
+	{0xB4DD, 0xB4F7, lbc_H3},
+	{0xB4F8, 0xB4F8, lbc_H2},
+	{0xB4F9, 0xB513, lbc_H3},
+	{0xB514, 0xB514, lbc_H2},
+	{0xB515, 0xB52F, lbc_H3},
+	{0xB530, 0xB530, lbc_H2},
+	{0xB531, 0xB54B, lbc_H3},
+	{0xB54C, 0xB54C, lbc_H2},
+	{0xB54D, 0xB567, lbc_H3},
+	{0xB568, 0xB568, lbc_H2},
+	{0xB569, 0xB583, lbc_H3},
+	{0xB584, 0xB584, lbc_H2},
+	{0xB585, 0xB59F, lbc_H3},
+	{0xB5A0, 0xB5A0, lbc_H2},
+	{0xB5A1, 0xB5BB, lbc_H3},
+	{0xB5BC, 0xB5BC, lbc_H2},
+	{0xB5BD, 0xB5D7, lbc_H3},
+	{0xB5D8, 0xB5D8, lbc_H2},
+	{0xB5D9, 0xB5F3, lbc_H3},
+	{0xB5F4, 0xB5F4, lbc_H2},
+	{0xB5F5, 0xB60F, lbc_H3},
+	{0xB610, 0xB610, lbc_H2},
+	{0xB611, 0xB62B, lbc_H3},
+	{0xB62C, 0xB62C, lbc_H2},
+	{0xB62D, 0xB647, lbc_H3},
+	{0xB648, 0xB648, lbc_H2},
+	{0xB649, 0xB663, lbc_H3},
+	{0xB664, 0xB664, lbc_H2},
+	{0xB665, 0xB67F, lbc_H3},
+	{0xB680, 0xB680, lbc_H2},
+	{0xB681, 0xB69B, lbc_H3},
+	{0xB69C, 0xB69C, lbc_H2},
+	{0xB69D, 0xB6B7, lbc_H3},
+	{0xB6B8, 0xB6B8, lbc_H2},
+	{0xB6B9, 0xB6D3, lbc_H3},
+	{0xB6D4, 0xB6D4, lbc_H2},
+	{0xB6D5, 0xB6EF, lbc_H3},
+	{0xB6F0, 0xB6F0, lbc_H2},
+	{0xB6F1, 0xB70B, lbc_H3},
+	{0xB70C, 0xB70C, lbc_H2},
+	{0xB70D, 0xB727, lbc_H3},
+	{0xB728, 0xB728, lbc_H2},
+	{0xB729, 0xB743, lbc_H3},
+	{0xB744, 0xB744, lbc_H2},
+	{0xB745, 0xB75F, lbc_H3},
+	{0xB760, 0xB760, lbc_H2},
+	{0xB761, 0xB77B, lbc_H3},
+	{0xB77C, 0xB77C, lbc_H2},
+	{0xB77D, 0xB797, lbc_H3},
+	{0xB798, 0xB798, lbc_H2},
+	{0xB799, 0xB7B3, lbc_H3},
+	{0xB7B4, 0xB7B4, lbc_H2},
+	{0xB7B5, 0xB7CF, lbc_H3},
+	{0xB7D0, 0xB7D0, lbc_H2},
+	{0xB7D1, 0xB7EB, lbc_H3},
+	{0xB7EC, 0xB7EC, lbc_H2},
+	{0xB7ED, 0xB807, lbc_H3},
+	{0xB808, 0xB808, lbc_H2},
+	{0xB809, 0xB823, lbc_H3},
+	{0xB824, 0xB824, lbc_H2},
+	{0xB825, 0xB83F, lbc_H3},
+	{0xB840, 0xB840, lbc_H2},
+	{0xB841, 0xB85B, lbc_H3},
+	{0xB85C, 0xB85C, lbc_H2},
+	{0xB85D, 0xB877, lbc_H3},
+	{0xB878, 0xB878, lbc_H2},
+	{0xB879, 0xB893, lbc_H3},
+	{0xB894, 0xB894, lbc_H2},
+	{0xB895, 0xB8AF, lbc_H3},
+	{0xB8B0, 0xB8B0, lbc_H2},
+	{0xB8B1, 0xB8CB, lbc_H3},
+	{0xB8CC, 0xB8CC, lbc_H2},
+	{0xB8CD, 0xB8E7, lbc_H3},
+	{0xB8E8, 0xB8E8, lbc_H2},
+	{0xB8E9, 0xB903, lbc_H3},
+	{0xB904, 0xB904, lbc_H2},
+	{0xB905, 0xB91F, lbc_H3},
+	{0xB920, 0xB920, lbc_H2},
+	{0xB921, 0xB93B, lbc_H3},
+	{0xB93C, 0xB93C, lbc_H2},
+	{0xB93D, 0xB957, lbc_H3},
+	{0xB958, 0xB958, lbc_H2},
+	{0xB959, 0xB973, lbc_H3},
+	{0xB974, 0xB974, lbc_H2},
+	{0xB975, 0xB98F, lbc_H3},
+	{0xB990, 0xB990, lbc_H2},
+	{0xB991, 0xB9AB, lbc_H3},
+	{0xB9AC, 0xB9AC, lbc_H2},
+	{0xB9AD, 0xB9C7, lbc_H3},
+	{0xB9C8, 0xB9C8, lbc_H2},
+	{0xB9C9, 0xB9E3, lbc_H3},
+	{0xB9E4, 0xB9E4, lbc_H2},
+	{0xB9E5, 0xB9FF, lbc_H3},
+	{0xBA00, 0xBA00, lbc_H2},
+	{0xBA01, 0xBA1B, lbc_H3},
+	{0xBA1C, 0xBA1C, lbc_H2},
+	{0xBA1D, 0xBA37, lbc_H3},
+	{0xBA38, 0xBA38, lbc_H2},
+	{0xBA39, 0xBA53, lbc_H3},
+	{0xBA54, 0xBA54, lbc_H2},
+	{0xBA55, 0xBA6F, lbc_H3},
+	{0xBA70, 0xBA70, lbc_H2},
+	{0xBA71, 0xBA8B, lbc_H3},
+	{0xBA8C, 0xBA8C, lbc_H2},
+	{0xBA8D, 0xBAA7, lbc_H3},
+	{0xBAA8, 0xBAA8, lbc_H2},
+	{0xBAA9, 0xBAC3, lbc_H3},
+	{0xBAC4, 0xBAC4, lbc_H2},
+	{0xBAC5, 0xBADF, lbc_H3},
+	{0xBAE0, 0xBAE0, lbc_H2},
+	{0xBAE1, 0xBAFB, lbc_H3},
+	{0xBAFC, 0xBAFC, lbc_H2},
+	{0xBAFD, 0xBB17, lbc_H3},
+	{0xBB18, 0xBB18, lbc_H2},
+	{0xBB19, 0xBB33, lbc_H3},
+	{0xBB34, 0xBB34, lbc_H2},
+	{0xBB35, 0xBB4F, lbc_H3},
+	{0xBB50, 0xBB50, lbc_H2},
+	{0xBB51, 0xBB6B, lbc_H3},
+	{0xBB6C, 0xBB6C, lbc_H2},
+	{0xBB6D, 0xBB87, lbc_H3},
+	{0xBB88, 0xBB88, lbc_H2},
+	{0xBB89, 0xBBA3, lbc_H3},
+	{0xBBA4, 0xBBA4, lbc_H2},
+	{0xBBA5, 0xBBBF, lbc_H3},
+	{0xBBC0, 0xBBC0, lbc_H2},
+	{0xBBC1, 0xBBDB, lbc_H3},
+	{0xBBDC, 0xBBDC, lbc_H2},
+	{0xBBDD, 0xBBF7, lbc_H3},
+	{0xBBF8, 0xBBF8, lbc_H2},
+	{0xBBF9, 0xBC13, lbc_H3},
+	{0xBC14, 0xBC14, lbc_H2},
+	{0xBC15, 0xBC2F, lbc_H3},
+	{0xBC30, 0xBC30, lbc_H2},
+	{0xBC31, 0xBC4B, lbc_H3},
+	{0xBC4C, 0xBC4C, lbc_H2},
+	{0xBC4D, 0xBC67, lbc_H3},
+	{0xBC68, 0xBC68, lbc_H2},
+	{0xBC69, 0xBC83, lbc_H3},
+	{0xBC84, 0xBC84, lbc_H2},
+	{0xBC85, 0xBC9F, lbc_H3},
+	{0xBCA0, 0xBCA0, lbc_H2},
+	{0xBCA1, 0xBCBB, lbc_H3},
+	{0xBCBC, 0xBCBC, lbc_H2},
+	{0xBCBD, 0xBCD7, lbc_H3},
+	{0xBCD8, 0xBCD8, lbc_H2},
+	{0xBCD9, 0xBCF3, lbc_H3},
+	{0xBCF4, 0xBCF4, lbc_H2},
+	{0xBCF5, 0xBD0F, lbc_H3},
+	{0xBD10, 0xBD10, lbc_H2},
+	{0xBD11, 0xBD2B, lbc_H3},
+	{0xBD2C, 0xBD2C, lbc_H2},
+	{0xBD2D, 0xBD47, lbc_H3},
+	{0xBD48, 0xBD48, lbc_H2},
+	{0xBD49, 0xBD63, lbc_H3},
+	{0xBD64, 0xBD64, lbc_H2},
+	{0xBD65, 0xBD7F, lbc_H3},
+	{0xBD80, 0xBD80, lbc_H2},
+	{0xBD81, 0xBD9B, lbc_H3},
+	{0xBD9C, 0xBD9C, lbc_H2},
+	{0xBD9D, 0xBDB7, lbc_H3},
+	{0xBDB8, 0xBDB8, lbc_H2},
+	{0xBDB9, 0xBDD3, lbc_H3},
+	{0xBDD4, 0xBDD4, lbc_H2},
+	{0xBDD5, 0xBDEF, lbc_H3},
+	{0xBDF0, 0xBDF0, lbc_H2},
+	{0xBDF1, 0xBE0B, lbc_H3},
+	{0xBE0C, 0xBE0C, lbc_H2},
+	{0xBE0D, 0xBE27, lbc_H3},
+	{0xBE28, 0xBE28, lbc_H2},
+	{0xBE29, 0xBE43, lbc_H3},
+	{0xBE44, 0xBE44, lbc_H2},
+	{0xBE45, 0xBE5F, lbc_H3},
+	{0xBE60, 0xBE60, lbc_H2},
+	{0xBE61, 0xBE7B, lbc_H3},
+	{0xBE7C, 0xBE7C, lbc_H2},
+	{0xBE7D, 0xBE97, lbc_H3},
+	{0xBE98, 0xBE98, lbc_H2},
+	{0xBE99, 0xBEB3, lbc_H3},
+	{0xBEB4, 0xBEB4, lbc_H2},
+	{0xBEB5, 0xBECF, lbc_H3},
+	{0xBED0, 0xBED0, lbc_H2},
+	{0xBED1, 0xBEEB, lbc_H3},
+	{0xBEEC, 0xBEEC, lbc_H2},
+	{0xBEED, 0xBF07, lbc_H3},
+	{0xBF08, 0xBF08, lbc_H2},
+	{0xBF09, 0xBF23, lbc_H3},
+	{0xBF24, 0xBF24, lbc_H2},
+	{0xBF25, 0xBF3F, lbc_H3},
+	{0xBF40, 0xBF40, lbc_H2},
+	{0xBF41, 0xBF5B, lbc_H3},
+	{0xBF5C, 0xBF5C, lbc_H2},
+	{0xBF5D, 0xBF77, lbc_H3},
+	{0xBF78, 0xBF78, lbc_H2},
+	{0xBF79, 0xBF93, lbc_H3},
+	{0xBF94, 0xBF94, lbc_H2},
+	{0xBF95, 0xBFAF, lbc_H3},
+	{0xBFB0, 0xBFB0, lbc_H2},
+	{0xBFB1, 0xBFCB, lbc_H3},
+	{0xBFCC, 0xBFCC, lbc_H2},
+	{0xBFCD, 0xBFE7, lbc_H3},
+	{0xBFE8, 0xBFE8, lbc_H2},
+	{0xBFE9, 0xC003, lbc_H3},
+	{0xC004, 0xC004, lbc_H2},
+	{0xC005, 0xC01F, lbc_H3},
+	{0xC020, 0xC020, lbc_H2},
+	{0xC021, 0xC03B, lbc_H3},
+	{0xC03C, 0xC03C, lbc_H2},
+	{0xC03D, 0xC057, lbc_H3},
+	{0xC058, 0xC058, lbc_H2},
+	{0xC059, 0xC073, lbc_H3},
+	{0xC074, 0xC074, lbc_H2},
+	{0xC075, 0xC08F, lbc_H3},
+	{0xC090, 0xC090, lbc_H2},
+	{0xC091, 0xC0AB, lbc_H3},
+	{0xC0AC, 0xC0AC, lbc_H2},
+	{0xC0AD, 0xC0C7, lbc_H3},
+	{0xC0C8, 0xC0C8, lbc_H2},
+	{0xC0C9, 0xC0E3, lbc_H3},
+	{0xC0E4, 0xC0E4, lbc_H2},
+	{0xC0E5, 0xC0FF, lbc_H3},
+	{0xC100, 0xC100, lbc_H2},
+	{0xC101, 0xC11B, lbc_H3},
+	{0xC11C, 0xC11C, lbc_H2},
+	{0xC11D, 0xC137, lbc_H3},
+	{0xC138, 0xC138, lbc_H2},
+	{0xC139, 0xC153, lbc_H3},
+	{0xC154, 0xC154, lbc_H2},
+	{0xC155, 0xC16F, lbc_H3},
+	{0xC170, 0xC170, lbc_H2},
+	{0xC171, 0xC18B, lbc_H3},
+	{0xC18C, 0xC18C, lbc_H2},
+	{0xC18D, 0xC1A7, lbc_H3},
+	{0xC1A8, 0xC1A8, lbc_H2},
+	{0xC1A9, 0xC1C3, lbc_H3},
+	{0xC1C4, 0xC1C4, lbc_H2},
+	{0xC1C5, 0xC1DF, lbc_H3},
+	{0xC1E0, 0xC1E0, lbc_H2},
+	{0xC1E1, 0xC1FB, lbc_H3},
+	{0xC1FC, 0xC1FC, lbc_H2},
+	{0xC1FD, 0xC217, lbc_H3},
+	{0xC218, 0xC218, lbc_H2},
+	{0xC219, 0xC233, lbc_H3},
+	{0xC234, 0xC234, lbc_H2},
+	{0xC235, 0xC24F, lbc_H3},
+	{0xC250, 0xC250, lbc_H2},
+	{0xC251, 0xC26B, lbc_H3},
+	{0xC26C, 0xC26C, lbc_H2},
+	{0xC26D, 0xC287, lbc_H3},
+	{0xC288, 0xC288, lbc_H2},
+	{0xC289, 0xC2A3, lbc_H3},
+	{0xC2A4, 0xC2A4, lbc_H2},
+	{0xC2A5, 0xC2BF, lbc_H3},
+	{0xC2C0, 0xC2C0, lbc_H2},
+	{0xC2C1, 0xC2DB, lbc_H3},
+	{0xC2DC, 0xC2DC, lbc_H2},
+	{0xC2DD, 0xC2F7, lbc_H3},
+	{0xC2F8, 0xC2F8, lbc_H2},
+	{0xC2F9, 0xC313, lbc_H3},
+	{0xC314, 0xC314, lbc_H2},
+	{0xC315, 0xC32F, lbc_H3},
+	{0xC330, 0xC330, lbc_H2},
+	{0xC331, 0xC34B, lbc_H3},
+	{0xC34C, 0xC34C, lbc_H2},
+	{0xC34D, 0xC367, lbc_H3},
+	{0xC368, 0xC368, lbc_H2},
+	{0xC369, 0xC383, lbc_H3},
+	{0xC384, 0xC384, lbc_H2},
+	{0xC385, 0xC39F, lbc_H3},
+	{0xC3A0, 0xC3A0, lbc_H2},
+	{0xC3A1, 0xC3BB, lbc_H3},
+	{0xC3BC, 0xC3BC, lbc_H2},
+	{0xC3BD, 0xC3D7, lbc_H3},
+	{0xC3D8, 0xC3D8, lbc_H2},
+	{0xC3D9, 0xC3F3, lbc_H3},
+	{0xC3F4, 0xC3F4, lbc_H2},
+	{0xC3F5, 0xC40F, lbc_H3},
+	{0xC410, 0xC410, lbc_H2},
+	{0xC411, 0xC42B, lbc_H3},
+	{0xC42C, 0xC42C, lbc_H2},
+	{0xC42D, 0xC447, lbc_H3},
+	{0xC448, 0xC448, lbc_H2},
+	{0xC449, 0xC463, lbc_H3},
+	{0xC464, 0xC464, lbc_H2},
+	{0xC465, 0xC47F, lbc_H3},
+	{0xC480, 0xC480, lbc_H2},
+	{0xC481, 0xC49B, lbc_H3},
+	{0xC49C, 0xC49C, lbc_H2},
+	{0xC49D, 0xC4B7, lbc_H3},
+	{0xC4B8, 0xC4B8, lbc_H2},
+	{0xC4B9, 0xC4D3, lbc_H3},
+	{0xC4D4, 0xC4D4, lbc_H2},
+	{0xC4D5, 0xC4EF, lbc_H3},
+	{0xC4F0, 0xC4F0, lbc_H2},
+	{0xC4F1, 0xC50B, lbc_H3},
+	{0xC50C, 0xC50C, lbc_H2},
+	{0xC50D, 0xC527, lbc_H3},
+	{0xC528, 0xC528, lbc_H2},
+	{0xC529, 0xC543, lbc_H3},
+	{0xC544, 0xC544, lbc_H2},
+	{0xC545, 0xC55F, lbc_H3},
+	{0xC560, 0xC560, lbc_H2},
+	{0xC561, 0xC57B, lbc_H3},
+	{0xC57C, 0xC57C, lbc_H2},
+	{0xC57D, 0xC597, lbc_H3},
+	{0xC598, 0xC598, lbc_H2},
+	{0xC599, 0xC5B3, lbc_H3},
+	{0xC5B4, 0xC5B4, lbc_H2},
+	{0xC5B5, 0xC5CF, lbc_H3},
+	{0xC5D0, 0xC5D0, lbc_H2},
+	{0xC5D1, 0xC5EB, lbc_H3},
+	{0xC5EC, 0xC5EC, lbc_H2},
+	{0xC5ED, 0xC607, lbc_H3},
+	{0xC608, 0xC608, lbc_H2},
+	{0xC609, 0xC623, lbc_H3},
+	{0xC624, 0xC624, lbc_H2},
+	{0xC625, 0xC63F, lbc_H3},
+	{0xC640, 0xC640, lbc_H2},
+	{0xC641, 0xC65B, lbc_H3},
+	{0xC65C, 0xC65C, lbc_H2},
+	{0xC65D, 0xC677, lbc_H3},
+	{0xC678, 0xC678, lbc_H2},
+	{0xC679, 0xC693, lbc_H3},
+	{0xC694, 0xC694, lbc_H2},
+	{0xC695, 0xC6AF, lbc_H3},
+	{0xC6B0, 0xC6B0, lbc_H2},
+	{0xC6B1, 0xC6CB, lbc_H3},
+	{0xC6CC, 0xC6CC, lbc_H2},
+	{0xC6CD, 0xC6E7, lbc_H3},
+	{0xC6E8, 0xC6E8, lbc_H2},
+	{0xC6E9, 0xC703, lbc_H3},
+	{0xC704, 0xC704, lbc_H2},
+	{0xC705, 0xC71F, lbc_H3},
+	{0xC720, 0xC720, lbc_H2},
+	{0xC721, 0xC73B, lbc_H3},
+	{0xC73C, 0xC73C, lbc_H2},
+	{0xC73D, 0xC757, lbc_H3},
+	{0xC758, 0xC758, lbc_H2},
+	{0xC759, 0xC773, lbc_H3},
+	{0xC774, 0xC774, lbc_H2},
+	{0xC775, 0xC78F, lbc_H3},
+	{0xC790, 0xC790, lbc_H2},
+	{0xC791, 0xC7AB, lbc_H3},
+	{0xC7AC, 0xC7AC, lbc_H2},
+	{0xC7AD, 0xC7C7, lbc_H3},
+	{0xC7C8, 0xC7C8, lbc_H2},
+	{0xC7C9, 0xC7E3, lbc_H3},
+	{0xC7E4, 0xC7E4, lbc_H2},
+	{0xC7E5, 0xC7FF, lbc_H3},
+	{0xC800, 0xC800, lbc_H2},
+	{0xC801, 0xC81B, lbc_H3},
+	{0xC81C, 0xC81C, lbc_H2},
+	{0xC81D, 0xC837, lbc_H3},
+	{0xC838, 0xC838, lbc_H2},
+	{0xC839, 0xC853, lbc_H3},
+	{0xC854, 0xC854, lbc_H2},
+	{0xC855, 0xC86F, lbc_H3},
+	{0xC870, 0xC870, lbc_H2},
+	{0xC871, 0xC88B, lbc_H3},
+	{0xC88C, 0xC88C, lbc_H2},
+	{0xC88D, 0xC8A7, lbc_H3},
+	{0xC8A8, 0xC8A8, lbc_H2},
+	{0xC8A9, 0xC8C3, lbc_H3},
+	{0xC8C4, 0xC8C4, lbc_H2},
+	{0xC8C5, 0xC8DF, lbc_H3},
+	{0xC8E0, 0xC8E0, lbc_H2},
+	{0xC8E1, 0xC8FB, lbc_H3},
+	{0xC8FC, 0xC8FC, lbc_H2},
+	{0xC8FD, 0xC917, lbc_H3},
+	{0xC918, 0xC918, lbc_H2},
+	{0xC919, 0xC933, lbc_H3},
+	{0xC934, 0xC934, lbc_H2},
+	{0xC935, 0xC94F, lbc_H3},
+	{0xC950, 0xC950, lbc_H2},
+	{0xC951, 0xC96B, lbc_H3},
+	{0xC96C, 0xC96C, lbc_H2},
+	{0xC96D, 0xC987, lbc_H3},
+	{0xC988, 0xC988, lbc_H2},
+	{0xC989, 0xC9A3, lbc_H3},
+	{0xC9A4, 0xC9A4, lbc_H2},
+	{0xC9A5, 0xC9BF, lbc_H3},
+	{0xC9C0, 0xC9C0, lbc_H2},
+	{0xC9C1, 0xC9DB, lbc_H3},
+	{0xC9DC, 0xC9DC, lbc_H2},
+	{0xC9DD, 0xC9F7, lbc_H3},
+	{0xC9F8, 0xC9F8, lbc_H2},
+	{0xC9F9, 0xCA13, lbc_H3},
+	{0xCA14, 0xCA14, lbc_H2},
+	{0xCA15, 0xCA2F, lbc_H3},
+	{0xCA30, 0xCA30, lbc_H2},
+	{0xCA31, 0xCA4B, lbc_H3},
+	{0xCA4C, 0xCA4C, lbc_H2},
+	{0xCA4D, 0xCA67, lbc_H3},
+	{0xCA68, 0xCA68, lbc_H2},
+	{0xCA69, 0xCA83, lbc_H3},
+	{0xCA84, 0xCA84, lbc_H2},
+	{0xCA85, 0xCA9F, lbc_H3},
+	{0xCAA0, 0xCAA0, lbc_H2},
+	{0xCAA1, 0xCABB, lbc_H3},
+	{0xCABC, 0xCABC, lbc_H2},
+	{0xCABD, 0xCAD7, lbc_H3},
+	{0xCAD8, 0xCAD8, lbc_H2},
+	{0xCAD9, 0xCAF3, lbc_H3},
+	{0xCAF4, 0xCAF4, lbc_H2},
+	{0xCAF5, 0xCB0F, lbc_H3},
+	{0xCB10, 0xCB10, lbc_H2},
+	{0xCB11, 0xCB2B, lbc_H3},
+	{0xCB2C, 0xCB2C, lbc_H2},
+	{0xCB2D, 0xCB47, lbc_H3},
+	{0xCB48, 0xCB48, lbc_H2},
+	{0xCB49, 0xCB63, lbc_H3},
+	{0xCB64, 0xCB64, lbc_H2},
+	{0xCB65, 0xCB7F, lbc_H3},
+	{0xCB80, 0xCB80, lbc_H2},
+	{0xCB81, 0xCB9B, lbc_H3},
+	{0xCB9C, 0xCB9C, lbc_H2},
+	{0xCB9D, 0xCBB7, lbc_H3},
+	{0xCBB8, 0xCBB8, lbc_H2},
+	{0xCBB9, 0xCBD3, lbc_H3},
+	{0xCBD4, 0xCBD4, lbc_H2},
+	{0xCBD5, 0xCBEF, lbc_H3},
+	{0xCBF0, 0xCBF0, lbc_H2},
+	{0xCBF1, 0xCC0B, lbc_H3},
+	{0xCC0C, 0xCC0C, lbc_H2},
+	{0xCC0D, 0xCC27, lbc_H3},
+	{0xCC28, 0xCC28, lbc_H2},
+	{0xCC29, 0xCC43, lbc_H3},
+	{0xCC44, 0xCC44, lbc_H2},
+	{0xCC45, 0xCC5F, lbc_H3},
+	{0xCC60, 0xCC60, lbc_H2},
+	{0xCC61, 0xCC7B, lbc_H3},
+	{0xCC7C, 0xCC7C, lbc_H2},
+	{0xCC7D, 0xCC97, lbc_H3},
+	{0xCC98, 0xCC98, lbc_H2},
+	{0xCC99, 0xCCB3, lbc_H3},
+	{0xCCB4, 0xCCB4, lbc_H2},
+	{0xCCB5, 0xCCCF, lbc_H3},
+	{0xCCD0, 0xCCD0, lbc_H2},
+	{0xCCD1, 0xCCEB, lbc_H3},
+	{0xCCEC, 0xCCEC, lbc_H2},
+	{0xCCED, 0xCD07, lbc_H3},
+	{0xCD08, 0xCD08, lbc_H2},
+	{0xCD09, 0xCD23, lbc_H3},
+	{0xCD24, 0xCD24, lbc_H2},
+	{0xCD25, 0xCD3F, lbc_H3},
+	{0xCD40, 0xCD40, lbc_H2},
+	{0xCD41, 0xCD5B, lbc_H3},
+	{0xCD5C, 0xCD5C, lbc_H2},
+	{0xCD5D, 0xCD77, lbc_H3},
+	{0xCD78, 0xCD78, lbc_H2},
+	{0xCD79, 0xCD93, lbc_H3},
+	{0xCD94, 0xCD94, lbc_H2},
+	{0xCD95, 0xCDAF, lbc_H3},
+	{0xCDB0, 0xCDB0, lbc_H2},
+	{0xCDB1, 0xCDCB, lbc_H3},
+	{0xCDCC, 0xCDCC, lbc_H2},
+	{0xCDCD, 0xCDE7, lbc_H3},
+	{0xCDE8, 0xCDE8, lbc_H2},
+	{0xCDE9, 0xCE03, lbc_H3},
+	{0xCE04, 0xCE04, lbc_H2},
+	{0xCE05, 0xCE1F, lbc_H3},
+	{0xCE20, 0xCE20, lbc_H2},
+	{0xCE21, 0xCE3B, lbc_H3},
+	{0xCE3C, 0xCE3C, lbc_H2},
+	{0xCE3D, 0xCE57, lbc_H3},
+	{0xCE58, 0xCE58, lbc_H2},
+	{0xCE59, 0xCE73, lbc_H3},
+	{0xCE74, 0xCE74, lbc_H2},
+	{0xCE75, 0xCE8F, lbc_H3},
+	{0xCE90, 0xCE90, lbc_H2},
+	{0xCE91, 0xCEAB, lbc_H3},
+	{0xCEAC, 0xCEAC, lbc_H2},
+	{0xCEAD, 0xCEC7, lbc_H3},
+	{0xCEC8, 0xCEC8, lbc_H2},
+	{0xCEC9, 0xCEE3, lbc_H3},
+	{0xCEE4, 0xCEE4, lbc_H2},
+	{0xCEE5, 0xCEFF, lbc_H3},
+	{0xCF00, 0xCF00, lbc_H2},
+	{0xCF01, 0xCF1B, lbc_H3},
+	{0xCF1C, 0xCF1C, lbc_H2},
+	{0xCF1D, 0xCF37, lbc_H3},
+	{0xCF38, 0xCF38, lbc_H2},
+	{0xCF39, 0xCF53, lbc_H3},
+	{0xCF54, 0xCF54, lbc_H2},
+	{0xCF55, 0xCF6F, lbc_H3},
+	{0xCF70, 0xCF70, lbc_H2},
+	{0xCF71, 0xCF8B, lbc_H3},
+	{0xCF8C, 0xCF8C, lbc_H2},
+	{0xCF8D, 0xCFA7, lbc_H3},
+	{0xCFA8, 0xCFA8, lbc_H2},
+	{0xCFA9, 0xCFC3, lbc_H3},
+	{0xCFC4, 0xCFC4, lbc_H2},
+	{0xCFC5, 0xCFDF, lbc_H3},
+	{0xCFE0, 0xCFE0, lbc_H2},
+	{0xCFE1, 0xCFFB, lbc_H3},
+	{0xCFFC, 0xCFFC, lbc_H2},
+	{0xCFFD, 0xD017, lbc_H3},
+	{0xD018, 0xD018, lbc_H2},
+	{0xD019, 0xD033, lbc_H3},
+	{0xD034, 0xD034, lbc_H2},
+	{0xD035, 0xD04F, lbc_H3},
+	{0xD050, 0xD050, lbc_H2},
+	{0xD051, 0xD06B, lbc_H3},
+	{0xD06C, 0xD06C, lbc_H2},
+	{0xD06D, 0xD087, lbc_H3},
+	{0xD088, 0xD088, lbc_H2},
+	{0xD089, 0xD0A3, lbc_H3},
+	{0xD0A4, 0xD0A4, lbc_H2},
+	{0xD0A5, 0xD0BF, lbc_H3},
+	{0xD0C0, 0xD0C0, lbc_H2},
+	{0xD0C1, 0xD0DB, lbc_H3},
+	{0xD0DC, 0xD0DC, lbc_H2},
+	{0xD0DD, 0xD0F7, lbc_H3},
+	{0xD0F8, 0xD0F8, lbc_H2},
+	{0xD0F9, 0xD113, lbc_H3},
+	{0xD114, 0xD114, lbc_H2},
+	{0xD115, 0xD12F, lbc_H3},
+	{0xD130, 0xD130, lbc_H2},
+	{0xD131, 0xD14B, lbc_H3},
+	{0xD14C, 0xD14C, lbc_H2},
+	{0xD14D, 0xD167, lbc_H3},
+	{0xD168, 0xD168, lbc_H2},
+	{0xD169, 0xD183, lbc_H3},
+	{0xD184, 0xD184, lbc_H2},
+	{0xD185, 0xD19F, lbc_H3},
+	{0xD1A0, 0xD1A0, lbc_H2},
+	{0xD1A1, 0xD1BB, lbc_H3},
+	{0xD1BC, 0xD1BC, lbc_H2},
+	{0xD1BD, 0xD1D7, lbc_H3},
+	{0xD1D8, 0xD1D8, lbc_H2},
+	{0xD1D9, 0xD1F3, lbc_H3},
+	{0xD1F4, 0xD1F4, lbc_H2},
+	{0xD1F5, 0xD20F, lbc_H3},
+	{0xD210, 0xD210, lbc_H2},
+	{0xD211, 0xD22B, lbc_H3},
+	{0xD22C, 0xD22C, lbc_H2},
+	{0xD22D, 0xD247, lbc_H3},
+	{0xD248, 0xD248, lbc_H2},
+	{0xD249, 0xD263, lbc_H3},
+	{0xD264, 0xD264, lbc_H2},
+	{0xD265, 0xD27F, lbc_H3},
+	{0xD280, 0xD280, lbc_H2},
+	{0xD281, 0xD29B, lbc_H3},
+	{0xD29C, 0xD29C, lbc_H2},
+	{0xD29D, 0xD2B7, lbc_H3},
+	{0xD2B8, 0xD2B8, lbc_H2},
+	{0xD2B9, 0xD2D3, lbc_H3},
+	{0xD2D4, 0xD2D4, lbc_H2},
+	{0xD2D5, 0xD2EF, lbc_H3},
+	{0xD2F0, 0xD2F0, lbc_H2},
+	{0xD2F1, 0xD30B, lbc_H3},
+	{0xD30C, 0xD30C, lbc_H2},
+	{0xD30D, 0xD327, lbc_H3},
+	{0xD328, 0xD328, lbc_H2},
+	{0xD329, 0xD343, lbc_H3},
+	{0xD344, 0xD344, lbc_H2},
+	{0xD345, 0xD35F, lbc_H3},
+	{0xD360, 0xD360, lbc_H2},
+	{0xD361, 0xD37B, lbc_H3},
+	{0xD37C, 0xD37C, lbc_H2},
+	{0xD37D, 0xD397, lbc_H3},
+	{0xD398, 0xD398, lbc_H2},
+	{0xD399, 0xD3B3, lbc_H3},
+	{0xD3B4, 0xD3B4, lbc_H2},
+	{0xD3B5, 0xD3CF, lbc_H3},
+	{0xD3D0, 0xD3D0, lbc_H2},
+	{0xD3D1, 0xD3EB, lbc_H3},
+	{0xD3EC, 0xD3EC, lbc_H2},
+	{0xD3ED, 0xD407, lbc_H3},
+	{0xD408, 0xD408, lbc_H2},
+	{0xD409, 0xD423, lbc_H3},
+	{0xD424, 0xD424, lbc_H2},
+	{0xD425, 0xD43F, lbc_H3},
+	{0xD440, 0xD440, lbc_H2},
+	{0xD441, 0xD45B, lbc_H3},
+	{0xD45C, 0xD45C, lbc_H2},
+	{0xD45D, 0xD477, lbc_H3},
+	{0xD478, 0xD478, lbc_H2},
+	{0xD479, 0xD493, lbc_H3},
+	{0xD494, 0xD494, lbc_H2},
+	{0xD495, 0xD4AF, lbc_H3},
+	{0xD4B0, 0xD4B0, lbc_H2},
+	{0xD4B1, 0xD4CB, lbc_H3},
+	{0xD4CC, 0xD4CC, lbc_H2},
+	{0xD4CD, 0xD4E7, lbc_H3},
+	{0xD4E8, 0xD4E8, lbc_H2},
+	{0xD4E9, 0xD503, lbc_H3},
+	{0xD504, 0xD504, lbc_H2},
+	{0xD505, 0xD51F, lbc_H3},
+	{0xD520, 0xD520, lbc_H2},
+	{0xD521, 0xD53B, lbc_H3},
+	{0xD53C, 0xD53C, lbc_H2},
+	{0xD53D, 0xD557, lbc_H3},
+	{0xD558, 0xD558, lbc_H2},
+	{0xD559, 0xD573, lbc_H3},
+	{0xD574, 0xD574, lbc_H2},
+	{0xD575, 0xD58F, lbc_H3},
+	{0xD590, 0xD590, lbc_H2},
+	{0xD591, 0xD5AB, lbc_H3},
+	{0xD5AC, 0xD5AC, lbc_H2},
+	{0xD5AD, 0xD5C7, lbc_H3},
+	{0xD5C8, 0xD5C8, lbc_H2},
+	{0xD5C9, 0xD5E3, lbc_H3},
+	{0xD5E4, 0xD5E4, lbc_H2},
+	{0xD5E5, 0xD5FF, lbc_H3},
+	{0xD600, 0xD600, lbc_H2},
+	{0xD601, 0xD61B, lbc_H3},
+	{0xD61C, 0xD61C, lbc_H2},
+	{0xD61D, 0xD637, lbc_H3},
+	{0xD638, 0xD638, lbc_H2},
+	{0xD639, 0xD653, lbc_H3},
+	{0xD654, 0xD654, lbc_H2},
+	{0xD655, 0xD66F, lbc_H3},
+	{0xD670, 0xD670, lbc_H2},
+	{0xD671, 0xD68B, lbc_H3},
+	{0xD68C, 0xD68C, lbc_H2},
+	{0xD68D, 0xD6A7, lbc_H3},
+	{0xD6A8, 0xD6A8, lbc_H2},
+	{0xD6A9, 0xD6C3, lbc_H3},
+	{0xD6C4, 0xD6C4, lbc_H2},
+	{0xD6C5, 0xD6DF, lbc_H3},
+	{0xD6E0, 0xD6E0, lbc_H2},
+	{0xD6E1, 0xD6FB, lbc_H3},
+	{0xD6FC, 0xD6FC, lbc_H2},
+	{0xD6FD, 0xD717, lbc_H3},
+	{0xD718, 0xD718, lbc_H2},
+	{0xD719, 0xD733, lbc_H3},
+	{0xD734, 0xD734, lbc_H2},
+	{0xD735, 0xD74F, lbc_H3},
+	{0xD750, 0xD750, lbc_H2},
+	{0xD751, 0xD76B, lbc_H3},
+	{0xD76C, 0xD76C, lbc_H2},
+	{0xD76D, 0xD787, lbc_H3},
+	{0xD788, 0xD788, lbc_H2},
+	{0xD789, 0xD7A3, lbc_H3},
+	{0xD7B0, 0xD7C6, lbc_JV},
+	{0xD7CB, 0xD7FB, lbc_JT},
+	{0xD800, 0xDFFF, lbc_SG},
+	{0xF900, 0xFAFF, lbc_ID},
+	{0xFB00, 0xFB06, lbc_AL},
+	{0xFB13, 0xFB17, lbc_AL},
+	{0xFB1D, 0xFB1D, lbc_HL},
+	{0xFB1E, 0xFB1E, lbc_CM},
+	{0xFB1F, 0xFB28, lbc_HL},
+	{0xFB29, 0xFB29, lbc_AL},
+	{0xFB2A, 0xFB36, lbc_HL},
+	{0xFB38, 0xFB3C, lbc_HL},
+	{0xFB3E, 0xFB3E, lbc_HL},
+	{0xFB40, 0xFB41, lbc_HL},
+	{0xFB43, 0xFB44, lbc_HL},
+	{0xFB46, 0xFB4F, lbc_HL},
+	{0xFB50, 0xFD3D, lbc_AL},
+	{0xFD3E, 0xFD3E, lbc_CL},
+	{0xFD3F, 0xFD3F, lbc_OP},
+	{0xFD40, 0xFDCF, lbc_AL},
+	{0xFDF0, 0xFDFB, lbc_AL},
+	{0xFDFC, 0xFDFC, lbc_PO},
+	{0xFDFD, 0xFDFF, lbc_AL},
+	{0xFE00, 0xFE0F, lbc_CM},
+	{0xFE10, 0xFE12, lbc_CL},
+	{0xFE13, 0xFE14, lbc_NS},
+	{0xFE15, 0xFE16, lbc_EX},
+	{0xFE17, 0xFE17, lbc_OP},
+	{0xFE18, 0xFE18, lbc_CL},
+	{0xFE19, 0xFE19, lbc_IN},
+	{0xFE20, 0xFE20, lbc_GL},
+	{0xFE21, 0xFE21, lbc_CM},
+	{0xFE22, 0xFE22, lbc_GL},
+	{0xFE23, 0xFE23, lbc_CM},
+	{0xFE24, 0xFE24, lbc_GL},
+	{0xFE25, 0xFE25, lbc_CM},
+	{0xFE26, 0xFE27, lbc_GL},
+	{0xFE28, 0xFE28, lbc_CM},
+	{0xFE29, 0xFE29, lbc_GL},
+	{0xFE2A, 0xFE2A, lbc_CM},
+	{0xFE2B, 0xFE2B, lbc_GL},
+	{0xFE2C, 0xFE2C, lbc_CM},
+	{0xFE2D, 0xFE2E, lbc_GL},
+	{0xFE2F, 0xFE2F, lbc_CM},
+	{0xFE30, 0xFE34, lbc_ID},
+	{0xFE35, 0xFE35, lbc_OP},
+	{0xFE36, 0xFE36, lbc_CL},
+	{0xFE37, 0xFE37, lbc_OP},
+	{0xFE38, 0xFE38, lbc_CL},
+	{0xFE39, 0xFE39, lbc_OP},
+	{0xFE3A, 0xFE3A, lbc_CL},
+	{0xFE3B, 0xFE3B, lbc_OP},
+	{0xFE3C, 0xFE3C, lbc_CL},
+	{0xFE3D, 0xFE3D, lbc_OP},
+	{0xFE3E, 0xFE3E, lbc_CL},
+	{0xFE3F, 0xFE3F, lbc_OP},
+	{0xFE40, 0xFE40, lbc_CL},
+	{0xFE41, 0xFE41, lbc_OP},
+	{0xFE42, 0xFE42, lbc_CL},
+	{0xFE43, 0xFE43, lbc_OP},
+	{0xFE44, 0xFE44, lbc_CL},
+	{0xFE45, 0xFE46, lbc_ID},
+	{0xFE47, 0xFE47, lbc_OP},
+	{0xFE48, 0xFE48, lbc_CL},
+	{0xFE49, 0xFE4F, lbc_ID},
+	{0xFE50, 0xFE50, lbc_CL},
+	{0xFE51, 0xFE51, lbc_ID},
+	{0xFE52, 0xFE52, lbc_CL},
+	{0xFE54, 0xFE55, lbc_NS},
+	{0xFE56, 0xFE57, lbc_EX},
+	{0xFE58, 0xFE58, lbc_ID},
+	{0xFE59, 0xFE59, lbc_OP},
+	{0xFE5A, 0xFE5A, lbc_CL},
+	{0xFE5B, 0xFE5B, lbc_OP},
+	{0xFE5C, 0xFE5C, lbc_CL},
+	{0xFE5D, 0xFE5D, lbc_OP},
+	{0xFE5E, 0xFE5E, lbc_CL},
+	{0xFE5F, 0xFE66, lbc_ID},
+	{0xFE68, 0xFE68, lbc_ID},
+	{0xFE69, 0xFE69, lbc_PR},
+	{0xFE6A, 0xFE6A, lbc_PO},
+	{0xFE6B, 0xFE6B, lbc_ID},
+	{0xFE70, 0xFE74, lbc_AL},
+	{0xFE76, 0xFEFC, lbc_AL},
+	{0xFEFF, 0xFEFF, lbc_WJ},
+	{0xFF01, 0xFF01, lbc_EX},
+	{0xFF02, 0xFF03, lbc_ID},
+	{0xFF04, 0xFF04, lbc_PR},
+	{0xFF05, 0xFF05, lbc_PO},
+	{0xFF06, 0xFF07, lbc_ID},
+	{0xFF08, 0xFF08, lbc_OP},
+	{0xFF09, 0xFF09, lbc_CL},
+	{0xFF0A, 0xFF0B, lbc_ID},
+	{0xFF0C, 0xFF0C, lbc_CL},
+	{0xFF0D, 0xFF0D, lbc_ID},
+	{0xFF0E, 0xFF0E, lbc_CL},
+	{0xFF0F, 0xFF19, lbc_ID},
+	{0xFF1A, 0xFF1B, lbc_NS},
+	{0xFF1C, 0xFF1E, lbc_ID},
+	{0xFF1F, 0xFF1F, lbc_EX},
+	{0xFF20, 0xFF3A, lbc_ID},
+	{0xFF3B, 0xFF3B, lbc_OP},
+	{0xFF3C, 0xFF3C, lbc_ID},
+	{0xFF3D, 0xFF3D, lbc_CL},
+	{0xFF3E, 0xFF5A, lbc_ID},
+	{0xFF5B, 0xFF5B, lbc_OP},
+	{0xFF5C, 0xFF5C, lbc_ID},
+	{0xFF5D, 0xFF5D, lbc_CL},
+	{0xFF5E, 0xFF5E, lbc_ID},
+	{0xFF5F, 0xFF5F, lbc_OP},
+	{0xFF60, 0xFF61, lbc_CL},
+	{0xFF62, 0xFF62, lbc_OP},
+	{0xFF63, 0xFF64, lbc_CL},
+	{0xFF65, 0xFF65, lbc_NS},
+	{0xFF66, 0xFF66, lbc_ID},
+	{0xFF67, 0xFF70, lbc_CJ},
+	{0xFF71, 0xFF9D, lbc_ID},
+	{0xFF9E, 0xFF9F, lbc_NS},
+	{0xFFA0, 0xFFBE, lbc_ID},
+	{0xFFC2, 0xFFC7, lbc_ID},
+	{0xFFCA, 0xFFCF, lbc_ID},
+	{0xFFD2, 0xFFD7, lbc_ID},
+	{0xFFDA, 0xFFDC, lbc_ID},
+	{0xFFE0, 0xFFE0, lbc_PO},
+	{0xFFE1, 0xFFE1, lbc_PR},
+	{0xFFE2, 0xFFE4, lbc_ID},
+	{0xFFE5, 0xFFE6, lbc_PR},
+	{0xFFE8, 0xFFEE, lbc_AL},
+	{0xFFF9, 0xFFFB, lbc_CM},
+	{0xFFFC, 0xFFFC, lbc_CB},
+	{0xFFFD, 0xFFFD, lbc_AI},
+	{0x10000, 0x1000B, lbc_AL},
+	{0x1000D, 0x10026, lbc_AL},
+	{0x10028, 0x1003A, lbc_AL},
+	{0x1003C, 0x1003D, lbc_AL},
+	{0x1003F, 0x1004D, lbc_AL},
+	{0x10050, 0x1005D, lbc_AL},
+	{0x10080, 0x100FA, lbc_AL},
+	{0x10100, 0x10102, lbc_BA},
+	{0x10107, 0x10133, lbc_AL},
+	{0x10137, 0x1018E, lbc_AL},
+	{0x10190, 0x1019C, lbc_AL},
+	{0x101A0, 0x101A0, lbc_AL},
+	{0x101D0, 0x101FC, lbc_AL},
+	{0x101FD, 0x101FD, lbc_CM},
+	{0x10280, 0x1029C, lbc_AL},
+	{0x102A0, 0x102D0, lbc_AL},
+	{0x102E0, 0x102E0, lbc_CM},
+	{0x102E1, 0x102FB, lbc_AL},
+	{0x10300, 0x10323, lbc_AL},
+	{0x1032D, 0x1034A, lbc_AL},
+	{0x10350, 0x10375, lbc_AL},
+	{0x10376, 0x1037A, lbc_CM},
+	{0x10380, 0x1039D, lbc_AL},
+	{0x1039F, 0x1039F, lbc_BA},
+	{0x103A0, 0x103C3, lbc_AL},
+	{0x103C8, 0x103CF, lbc_AL},
+	{0x103D0, 0x103D0, lbc_BA},
+	{0x103D1, 0x103D5, lbc_AL},
+	{0x10400, 0x1049D, lbc_AL},
+	{0x104A0, 0x104A9, lbc_NU},
+	{0x104B0, 0x104D3, lbc_AL},
+	{0x104D8, 0x104FB, lbc_AL},
+	{0x10500, 0x10527, lbc_AL},
+	{0x10530, 0x10563, lbc_AL},
+	{0x1056F, 0x1057A, lbc_AL},
+	{0x1057C, 0x1058A, lbc_AL},
+	{0x1058C, 0x10592, lbc_AL},
+	{0x10594, 0x10595, lbc_AL},
+	{0x10597, 0x105A1, lbc_AL},
+	{0x105A3, 0x105B1, lbc_AL},
+	{0x105B3, 0x105B9, lbc_AL},
+	{0x105BB, 0x105BC, lbc_AL},
+	{0x105C0, 0x105F3, lbc_AL},
+	{0x10600, 0x10736, lbc_AL},
+	{0x10740, 0x10755, lbc_AL},
+	{0x10760, 0x10767, lbc_AL},
+	{0x10780, 0x10785, lbc_AL},
+	{0x10787, 0x107B0, lbc_AL},
+	{0x107B2, 0x107BA, lbc_AL},
+	{0x10800, 0x10805, lbc_AL},
+	{0x10808, 0x10808, lbc_AL},
+	{0x1080A, 0x10835, lbc_AL},
+	{0x10837, 0x10838, lbc_AL},
+	{0x1083C, 0x1083C, lbc_AL},
+	{0x1083F, 0x10855, lbc_AL},
+	{0x10857, 0x10857, lbc_BA},
+	{0x10858, 0x1089E, lbc_AL},
+	{0x108A7, 0x108AF, lbc_AL},
+	{0x108E0, 0x108F2, lbc_AL},
+	{0x108F4, 0x108F5, lbc_AL},
+	{0x108FB, 0x1091B, lbc_AL},
+	{0x1091F, 0x1091F, lbc_BA},
+	{0x10920, 0x10939, lbc_AL},
+	{0x1093F, 0x10959, lbc_AL},
+	{0x10980, 0x109B7, lbc_AL},
+	{0x109BC, 0x109CF, lbc_AL},
+	{0x109D2, 0x10A00, lbc_AL},
+	{0x10A01, 0x10A03, lbc_CM},
+	{0x10A05, 0x10A06, lbc_CM},
+	{0x10A0C, 0x10A0F, lbc_CM},
+	{0x10A10, 0x10A13, lbc_AL},
+	{0x10A15, 0x10A17, lbc_AL},
+	{0x10A19, 0x10A35, lbc_AL},
+	{0x10A38, 0x10A3A, lbc_CM},
+	{0x10A3F, 0x10A3F, lbc_CM},
+	{0x10A40, 0x10A48, lbc_AL},
+	{0x10A50, 0x10A57, lbc_BA},
+	{0x10A58, 0x10A58, lbc_AL},
+	{0x10A60, 0x10A9F, lbc_AL},
+	{0x10AC0, 0x10AE4, lbc_AL},
+	{0x10AE5, 0x10AE6, lbc_CM},
+	{0x10AEB, 0x10AEF, lbc_AL},
+	{0x10AF0, 0x10AF5, lbc_BA},
+	{0x10AF6, 0x10AF6, lbc_IN},
+	{0x10B00, 0x10B35, lbc_AL},
+	{0x10B39, 0x10B3F, lbc_BA},
+	{0x10B40, 0x10B55, lbc_AL},
+	{0x10B58, 0x10B72, lbc_AL},
+	{0x10B78, 0x10B91, lbc_AL},
+	{0x10B99, 0x10B9C, lbc_AL},
+	{0x10BA9, 0x10BAF, lbc_AL},
+	{0x10C00, 0x10C48, lbc_AL},
+	{0x10C80, 0x10CB2, lbc_AL},
+	{0x10CC0, 0x10CF2, lbc_AL},
+	{0x10CFA, 0x10D23, lbc_AL},
+	{0x10D24, 0x10D27, lbc_CM},
+	{0x10D30, 0x10D39, lbc_NU},
+	{0x10D40, 0x10D49, lbc_NU},
+	{0x10D4A, 0x10D65, lbc_AL},
+	{0x10D69, 0x10D6D, lbc_CM},
+	{0x10D6E, 0x10D6E, lbc_HH},
+	{0x10D6F, 0x10D85, lbc_AL},
+	{0x10D8E, 0x10D8F, lbc_AL},
+	{0x10E60, 0x10E7E, lbc_AL},
+	{0x10E80, 0x10EA9, lbc_AL},
+	{0x10EAB, 0x10EAC, lbc_CM},
+	{0x10EAD, 0x10EAD, lbc_HH},
+	{0x10EB0, 0x10EB1, lbc_AL},
+	{0x10EC2, 0x10EC7, lbc_AL},
+	{0x10ED0, 0x10ED0, lbc_BA},
+	{0x10ED1, 0x10ED8, lbc_AL},
+	{0x10EFA, 0x10EFF, lbc_CM},
+	{0x10F00, 0x10F27, lbc_AL},
+	{0x10F30, 0x10F45, lbc_AL},
+	{0x10F46, 0x10F50, lbc_CM},
+	{0x10F51, 0x10F59, lbc_AL},
+	{0x10F70, 0x10F81, lbc_AL},
+	{0x10F82, 0x10F85, lbc_CM},
+	{0x10F86, 0x10F89, lbc_AL},
+	{0x10FB0, 0x10FCB, lbc_AL},
+	{0x10FE0, 0x10FF6, lbc_AL},
+	{0x11000, 0x11002, lbc_CM},
+	{0x11003, 0x11004, lbc_AP},
+	{0x11005, 0x11037, lbc_AK},
+	{0x11038, 0x11045, lbc_CM},
+	{0x11046, 0x11046, lbc_VI},
+	{0x11047, 0x11048, lbc_BA},
+	{0x11049, 0x1104D, lbc_ID},
+	{0x11052, 0x11065, lbc_ID},
+	{0x11066, 0x1106F, lbc_AS},
+	{0x11070, 0x11070, lbc_CM},
+	{0x11071, 0x11072, lbc_AK},
+	{0x11073, 0x11074, lbc_CM},
+	{0x11075, 0x11075, lbc_AK},
+	{0x1107F, 0x1107F, lbc_GL},
+	{0x11080, 0x11082, lbc_CM},
+	{0x11083, 0x110AF, lbc_AL},
+	{0x110B0, 0x110BA, lbc_CM},
+	{0x110BB, 0x110BC, lbc_AL},
+	{0x110BD, 0x110BD, lbc_NU},
+	{0x110BE, 0x110C1, lbc_BA},
+	{0x110C2, 0x110C2, lbc_CM},
+	{0x110CD, 0x110CD, lbc_NU},
+	{0x110D0, 0x110E8, lbc_AL},
+	{0x110F0, 0x110F9, lbc_NU},
+	{0x11100, 0x11102, lbc_CM},
+	{0x11103, 0x11126, lbc_AL},
+	{0x11127, 0x11134, lbc_CM},
+	{0x11136, 0x1113F, lbc_NU},
+	{0x11140, 0x11143, lbc_BA},
+	{0x11144, 0x11144, lbc_AL},
+	{0x11145, 0x11146, lbc_CM},
+	{0x11147, 0x11147, lbc_AL},
+	{0x11150, 0x11172, lbc_AL},
+	{0x11173, 0x11173, lbc_CM},
+	{0x11174, 0x11174, lbc_AL},
+	{0x11175, 0x11175, lbc_BB},
+	{0x11176, 0x11176, lbc_AL},
+	{0x11180, 0x11182, lbc_CM},
+	{0x11183, 0x111B2, lbc_AL},
+	{0x111B3, 0x111C0, lbc_CM},
+	{0x111C1, 0x111C4, lbc_AL},
+	{0x111C5, 0x111C6, lbc_BA},
+	{0x111C7, 0x111C7, lbc_AL},
+	{0x111C8, 0x111C8, lbc_BA},
+	{0x111C9, 0x111CC, lbc_CM},
+	{0x111CD, 0x111CD, lbc_AL},
+	{0x111CE, 0x111CF, lbc_CM},
+	{0x111D0, 0x111D9, lbc_NU},
+	{0x111DA, 0x111DA, lbc_AL},
+	{0x111DB, 0x111DB, lbc_BB},
+	{0x111DC, 0x111DC, lbc_AL},
+	{0x111DD, 0x111DF, lbc_BA},
+	{0x111E1, 0x111F4, lbc_AL},
+	{0x11200, 0x11211, lbc_AL},
+	{0x11213, 0x1122B, lbc_AL},
+	{0x1122C, 0x11237, lbc_CM},
+	{0x11238, 0x11239, lbc_BA},
+	{0x1123A, 0x1123A, lbc_AL},
+	{0x1123B, 0x1123C, lbc_BA},
+	{0x1123D, 0x1123D, lbc_AL},
+	{0x1123E, 0x1123E, lbc_CM},
+	{0x1123F, 0x11240, lbc_AL},
+	{0x11241, 0x11241, lbc_CM},
+	{0x11280, 0x11286, lbc_AL},
+	{0x11288, 0x11288, lbc_AL},
+	{0x1128A, 0x1128D, lbc_AL},
+	{0x1128F, 0x1129D, lbc_AL},
+	{0x1129F, 0x112A8, lbc_AL},
+	{0x112A9, 0x112A9, lbc_BA},
+	{0x112B0, 0x112DE, lbc_AL},
+	{0x112DF, 0x112EA, lbc_CM},
+	{0x112F0, 0x112F9, lbc_NU},
+	{0x11300, 0x11303, lbc_CM},
+	{0x11305, 0x1130C, lbc_AK},
+	{0x1130F, 0x11310, lbc_AK},
+	{0x11313, 0x11328, lbc_AK},
+	{0x1132A, 0x11330, lbc_AK},
+	{0x11332, 0x11333, lbc_AK},
+	{0x11335, 0x11339, lbc_AK},
+	{0x1133B, 0x1133C, lbc_CM},
+	{0x1133D, 0x1133D, lbc_BA},
+	{0x1133E, 0x11344, lbc_CM},
+	{0x11347, 0x11348, lbc_CM},
+	{0x1134B, 0x1134C, lbc_CM},
+	{0x1134D, 0x1134D, lbc_VI},
+	{0x11350, 0x11350, lbc_AS},
+	{0x11357, 0x11357, lbc_CM},
+	{0x1135D, 0x1135D, lbc_BA},
+	{0x1135E, 0x1135F, lbc_AS},
+	{0x11360, 0x11361, lbc_AK},
+	{0x11362, 0x11363, lbc_CM},
+	{0x11366, 0x1136C, lbc_CM},
+	{0x11370, 0x11374, lbc_CM},
+	{0x11380, 0x11389, lbc_AS},
+	{0x1138B, 0x1138B, lbc_AS},
+	{0x1138E, 0x1138E, lbc_AS},
+	{0x11390, 0x11391, lbc_AS},
+	{0x11392, 0x113B5, lbc_AK},
+	{0x113B7, 0x113B7, lbc_ID},
+	{0x113B8, 0x113C0, lbc_CM},
+	{0x113C2, 0x113C2, lbc_CM},
+	{0x113C5, 0x113C5, lbc_CM},
+	{0x113C7, 0x113CA, lbc_CM},
+	{0x113CC, 0x113CF, lbc_CM},
+	{0x113D0, 0x113D0, lbc_VI},
+	{0x113D1, 0x113D1, lbc_AP},
+	{0x113D2, 0x113D2, lbc_CM},
+	{0x113D3, 0x113D5, lbc_ID},
+	{0x113D7, 0x113D8, lbc_ID},
+	{0x113E1, 0x113E2, lbc_CM},
+	{0x11400, 0x11434, lbc_AL},
+	{0x11435, 0x11446, lbc_CM},
+	{0x11447, 0x1144A, lbc_AL},
+	{0x1144B, 0x1144E, lbc_BA},
+	{0x1144F, 0x1144F, lbc_AL},
+	{0x11450, 0x11459, lbc_NU},
+	{0x1145A, 0x1145B, lbc_BA},
+	{0x1145D, 0x1145D, lbc_AL},
+	{0x1145E, 0x1145E, lbc_CM},
+	{0x1145F, 0x11461, lbc_AL},
+	{0x11480, 0x114AF, lbc_AL},
+	{0x114B0, 0x114C3, lbc_CM},
+	{0x114C4, 0x114C7, lbc_AL},
+	{0x114D0, 0x114D9, lbc_NU},
+	{0x11580, 0x115AE, lbc_AL},
+	{0x115AF, 0x115B5, lbc_CM},
+	{0x115B8, 0x115C0, lbc_CM},
+	{0x115C1, 0x115C1, lbc_BB},
+	{0x115C2, 0x115C3, lbc_BA},
+	{0x115C4, 0x115C5, lbc_EX},
+	{0x115C6, 0x115C8, lbc_AL},
+	{0x115C9, 0x115D7, lbc_BA},
+	{0x115D8, 0x115DB, lbc_AL},
+	{0x115DC, 0x115DD, lbc_CM},
+	{0x11600, 0x1162F, lbc_AL},
+	{0x11630, 0x11640, lbc_CM},
+	{0x11641, 0x11642, lbc_BA},
+	{0x11643, 0x11644, lbc_AL},
+	{0x11650, 0x11659, lbc_NU},
+	{0x11660, 0x1166C, lbc_BB},
+	{0x11680, 0x116AA, lbc_AL},
+	{0x116AB, 0x116B7, lbc_CM},
+	{0x116B8, 0x116B9, lbc_AL},
+	{0x116C0, 0x116C9, lbc_NU},
+	{0x116D0, 0x116E3, lbc_NU},
+	{0x11700, 0x1171A, lbc_SA},
+	{0x1171D, 0x1172B, lbc_SA},
+	{0x11730, 0x11739, lbc_NU},
+	{0x1173A, 0x1173B, lbc_SA},
+	{0x1173C, 0x1173E, lbc_BA},
+	{0x1173F, 0x11746, lbc_SA},
+	{0x11800, 0x1182B, lbc_AL},
+	{0x1182C, 0x1183A, lbc_CM},
+	{0x1183B, 0x1183B, lbc_AL},
+	{0x118A0, 0x118DF, lbc_AL},
+	{0x118E0, 0x118E9, lbc_NU},
+	{0x118EA, 0x118F2, lbc_AL},
+	{0x118FF, 0x118FF, lbc_AL},
+	{0x11900, 0x11906, lbc_AK},
+	{0x11909, 0x11909, lbc_AK},
+	{0x1190C, 0x11913, lbc_AK},
+	{0x11915, 0x11916, lbc_AK},
+	{0x11918, 0x1192F, lbc_AK},
+	{0x11930, 0x11935, lbc_CM},
+	{0x11937, 0x11938, lbc_CM},
+	{0x1193B, 0x1193D, lbc_CM},
+	{0x1193E, 0x1193E, lbc_VI},
+	{0x1193F, 0x1193F, lbc_AP},
+	{0x11940, 0x11940, lbc_CM},
+	{0x11941, 0x11941, lbc_AP},
+	{0x11942, 0x11943, lbc_CM},
+	{0x11944, 0x11946, lbc_BA},
+	{0x11950, 0x11959, lbc_AS},
+	{0x119A0, 0x119A7, lbc_AL},
+	{0x119AA, 0x119D0, lbc_AL},
+	{0x119D1, 0x119D7, lbc_CM},
+	{0x119DA, 0x119E0, lbc_CM},
+	{0x119E1, 0x119E1, lbc_AL},
+	{0x119E2, 0x119E2, lbc_BB},
+	{0x119E3, 0x119E3, lbc_AL},
+	{0x119E4, 0x119E4, lbc_CM},
+	{0x11A00, 0x11A00, lbc_AL},
+	{0x11A01, 0x11A0A, lbc_CM},
+	{0x11A0B, 0x11A32, lbc_AL},
+	{0x11A33, 0x11A39, lbc_CM},
+	{0x11A3A, 0x11A3A, lbc_AL},
+	{0x11A3B, 0x11A3E, lbc_CM},
+	{0x11A3F, 0x11A3F, lbc_BB},
+	{0x11A40, 0x11A40, lbc_AL},
+	{0x11A41, 0x11A44, lbc_BA},
+	{0x11A45, 0x11A45, lbc_BB},
+	{0x11A46, 0x11A46, lbc_AL},
+	{0x11A47, 0x11A47, lbc_CM},
+	{0x11A50, 0x11A50, lbc_AL},
+	{0x11A51, 0x11A5B, lbc_CM},
+	{0x11A5C, 0x11A89, lbc_AL},
+	{0x11A8A, 0x11A99, lbc_CM},
+	{0x11A9A, 0x11A9C, lbc_BA},
+	{0x11A9D, 0x11A9D, lbc_AL},
+	{0x11A9E, 0x11AA0, lbc_BB},
+	{0x11AA1, 0x11AA2, lbc_BA},
+	{0x11AB0, 0x11AF8, lbc_AL},
+	{0x11B00, 0x11B09, lbc_BB},
+	{0x11B60, 0x11B67, lbc_CM},
+	{0x11BC0, 0x11BE1, lbc_AL},
+	{0x11BF0, 0x11BF9, lbc_NU},
+	{0x11C00, 0x11C08, lbc_AL},
+	{0x11C0A, 0x11C2E, lbc_AL},
+	{0x11C2F, 0x11C36, lbc_CM},
+	{0x11C38, 0x11C3F, lbc_CM},
+	{0x11C40, 0x11C40, lbc_AL},
+	{0x11C41, 0x11C45, lbc_BA},
+	{0x11C50, 0x11C59, lbc_NU},
+	{0x11C5A, 0x11C6C, lbc_AL},
+	{0x11C70, 0x11C70, lbc_BB},
+	{0x11C71, 0x11C71, lbc_EX},
+	{0x11C72, 0x11C8F, lbc_AL},
+	{0x11C92, 0x11CA7, lbc_CM},
+	{0x11CA9, 0x11CB6, lbc_CM},
+	{0x11D00, 0x11D06, lbc_AL},
+	{0x11D08, 0x11D09, lbc_AL},
+	{0x11D0B, 0x11D30, lbc_AL},
+	{0x11D31, 0x11D36, lbc_CM},
+	{0x11D3A, 0x11D3A, lbc_CM},
+	{0x11D3C, 0x11D3D, lbc_CM},
+	{0x11D3F, 0x11D45, lbc_CM},
+	{0x11D46, 0x11D46, lbc_AL},
+	{0x11D47, 0x11D47, lbc_CM},
+	{0x11D50, 0x11D59, lbc_NU},
+	{0x11D60, 0x11D65, lbc_AL},
+	{0x11D67, 0x11D68, lbc_AL},
+	{0x11D6A, 0x11D89, lbc_AL},
+	{0x11D8A, 0x11D8E, lbc_CM},
+	{0x11D90, 0x11D91, lbc_CM},
+	{0x11D93, 0x11D97, lbc_CM},
+	{0x11D98, 0x11D98, lbc_AL},
+	{0x11DA0, 0x11DA9, lbc_NU},
+	{0x11DB0, 0x11DDB, lbc_AL},
+	{0x11DE0, 0x11DE9, lbc_NU},
+	{0x11EE0, 0x11EF1, lbc_AS},
+	{0x11EF2, 0x11EF2, lbc_BA},
+	{0x11EF3, 0x11EF6, lbc_CM},
+	{0x11EF7, 0x11EF8, lbc_BA},
+	{0x11F00, 0x11F01, lbc_CM},
+	{0x11F02, 0x11F02, lbc_AP},
+	{0x11F03, 0x11F03, lbc_CM},
+	{0x11F04, 0x11F10, lbc_AK},
+	{0x11F12, 0x11F33, lbc_AK},
+	{0x11F34, 0x11F3A, lbc_CM},
+	{0x11F3E, 0x11F41, lbc_CM},
+	{0x11F42, 0x11F42, lbc_VI},
+	{0x11F43, 0x11F44, lbc_BA},
+	{0x11F45, 0x11F4F, lbc_ID},
+	{0x11F50, 0x11F59, lbc_AS},
+	{0x11F5A, 0x11F5A, lbc_CM},
+	{0x11FB0, 0x11FB0, lbc_AL},
+	{0x11FC0, 0x11FDC, lbc_AL},
+	{0x11FDD, 0x11FE0, lbc_PO},
+	{0x11FE1, 0x11FF1, lbc_AL},
+	{0x11FFF, 0x11FFF, lbc_BA},
+	{0x12000, 0x12399, lbc_AL},
+	{0x12400, 0x1246E, lbc_AL},
+	{0x12470, 0x12474, lbc_BA},
+	{0x12480, 0x12543, lbc_AL},
+	{0x12F90, 0x12FF2, lbc_AL},
+	{0x13000, 0x13257, lbc_AL},
+	{0x13258, 0x1325A, lbc_OP},
+	{0x1325B, 0x1325D, lbc_CL},
+	{0x1325E, 0x13281, lbc_AL},
+	{0x13282, 0x13282, lbc_CL},
+	{0x13283, 0x13285, lbc_AL},
+	{0x13286, 0x13286, lbc_OP},
+	{0x13287, 0x13287, lbc_CL},
+	{0x13288, 0x13288, lbc_OP},
+	{0x13289, 0x13289, lbc_CL},
+	{0x1328A, 0x13378, lbc_AL},
+	{0x13379, 0x13379, lbc_OP},
+	{0x1337A, 0x1337B, lbc_CL},
+	{0x1337C, 0x1342E, lbc_AL},
+	{0x1342F, 0x1342F, lbc_OP},
+	{0x13430, 0x13436, lbc_GL},
+	{0x13437, 0x13437, lbc_OP},
+	{0x13438, 0x13438, lbc_CL},
+	{0x13439, 0x1343B, lbc_GL},
+	{0x1343C, 0x1343C, lbc_OP},
+	{0x1343D, 0x1343D, lbc_CL},
+	{0x1343E, 0x1343E, lbc_OP},
+	{0x1343F, 0x1343F, lbc_CL},
+	{0x13440, 0x13440, lbc_CM},
+	{0x13441, 0x13446, lbc_AL},
+	{0x13447, 0x13455, lbc_CM},
+	{0x13460, 0x143FA, lbc_AL},
+	{0x14400, 0x145CD, lbc_AL},
+	{0x145CE, 0x145CE, lbc_OP},
+	{0x145CF, 0x145CF, lbc_CL},
+	{0x145D0, 0x14646, lbc_AL},
+	{0x16100, 0x1611D, lbc_AS},
+	{0x1611E, 0x1612F, lbc_CM},
+	{0x16130, 0x16139, lbc_AS},
+	{0x16800, 0x16A38, lbc_AL},
+	{0x16A40, 0x16A5E, lbc_AL},
+	{0x16A60, 0x16A69, lbc_NU},
+	{0x16A6E, 0x16A6F, lbc_BA},
+	{0x16A70, 0x16ABE, lbc_AL},
+	{0x16AC0, 0x16AC9, lbc_NU},
+	{0x16AD0, 0x16AED, lbc_AL},
+	{0x16AF0, 0x16AF4, lbc_CM},
+	{0x16AF5, 0x16AF5, lbc_BA},
+	{0x16B00, 0x16B2F, lbc_AL},
+	{0x16B30, 0x16B36, lbc_CM},
+	{0x16B37, 0x16B39, lbc_BA},
+	{0x16B3A, 0x16B43, lbc_AL},
+	{0x16B44, 0x16B44, lbc_BA},
+	{0x16B45, 0x16B45, lbc_AL},
+	{0x16B50, 0x16B59, lbc_NU},
+	{0x16B5B, 0x16B61, lbc_AL},
+	{0x16B63, 0x16B77, lbc_AL},
+	{0x16B7D, 0x16B8F, lbc_AL},
+	{0x16D40, 0x16D6D, lbc_AL},
+	{0x16D6E, 0x16D6F, lbc_BA},
+	{0x16D70, 0x16D79, lbc_NU},
+	{0x16E40, 0x16E96, lbc_AL},
+	{0x16E97, 0x16E98, lbc_BA},
+	{0x16E99, 0x16E9A, lbc_AL},
+	{0x16EA0, 0x16EB8, lbc_AL},
+	{0x16EBB, 0x16ED3, lbc_AL},
+	{0x16F00, 0x16F4A, lbc_AL},
+	{0x16F4F, 0x16F4F, lbc_CM},
+	{0x16F50, 0x16F50, lbc_AL},
+	{0x16F51, 0x16F87, lbc_CM},
+	{0x16F8F, 0x16F92, lbc_CM},
+	{0x16F93, 0x16F9F, lbc_AL},
+	{0x16FE0, 0x16FE3, lbc_NS},
+	{0x16FE4, 0x16FE4, lbc_GL},
+	{0x16FF0, 0x16FF1, lbc_CM},
+	{0x16FF2, 0x16FF3, lbc_NS},
+	{0x16FF4, 0x16FF6, lbc_ID},
+	{0x17000, 0x18AFF, lbc_ID},
+	{0x18B00, 0x18CD5, lbc_AL},
+	{0x18CFF, 0x18CFF, lbc_AL},
+	{0x18D00, 0x18D1E, lbc_ID},
+	{0x18D80, 0x18DF2, lbc_ID},
+	{0x1AFF0, 0x1AFF3, lbc_AL},
+	{0x1AFF5, 0x1AFFB, lbc_AL},
+	{0x1AFFD, 0x1AFFE, lbc_AL},
+	{0x1B000, 0x1B122, lbc_ID},
+	{0x1B132, 0x1B132, lbc_CJ},
+	{0x1B150, 0x1B152, lbc_CJ},
+	{0x1B155, 0x1B155, lbc_CJ},
+	{0x1B164, 0x1B167, lbc_CJ},
+	{0x1B170, 0x1B2FB, lbc_ID},
+	{0x1BC00, 0x1BC6A, lbc_AL},
+	{0x1BC70, 0x1BC7C, lbc_AL},
+	{0x1BC80, 0x1BC88, lbc_AL},
+	{0x1BC90, 0x1BC99, lbc_AL},
+	{0x1BC9C, 0x1BC9C, lbc_AL},
+	{0x1BC9D, 0x1BC9E, lbc_CM},
+	{0x1BC9F, 0x1BC9F, lbc_BA},
+	{0x1BCA0, 0x1BCA3, lbc_CM},
+	{0x1CC00, 0x1CCEF, lbc_AL},
+	{0x1CCF0, 0x1CCF9, lbc_NU},
+	{0x1CCFA, 0x1CCFC, lbc_AL},
+	{0x1CD00, 0x1CEB3, lbc_AL},
+	{0x1CEBA, 0x1CED0, lbc_AL},
+	{0x1CEE0, 0x1CEF0, lbc_AL},
+	{0x1CF00, 0x1CF2D, lbc_CM},
+	{0x1CF30, 0x1CF46, lbc_CM},
+	{0x1CF50, 0x1CFC3, lbc_AL},
+	{0x1D000, 0x1D0F5, lbc_AL},
+	{0x1D100, 0x1D126, lbc_AL},
+	{0x1D129, 0x1D164, lbc_AL},
+	{0x1D165, 0x1D169, lbc_CM},
+	{0x1D16A, 0x1D16C, lbc_AL},
+	{0x1D16D, 0x1D182, lbc_CM},
+	{0x1D183, 0x1D184, lbc_AL},
+	{0x1D185, 0x1D18B, lbc_CM},
+	{0x1D18C, 0x1D1A9, lbc_AL},
+	{0x1D1AA, 0x1D1AD, lbc_CM},
+	{0x1D1AE, 0x1D1EA, lbc_AL},
+	{0x1D200, 0x1D241, lbc_AL},
+	{0x1D242, 0x1D244, lbc_CM},
+	{0x1D245, 0x1D245, lbc_AL},
+	{0x1D2C0, 0x1D2D3, lbc_AL},
+	{0x1D2E0, 0x1D2F3, lbc_AL},
+	{0x1D300, 0x1D356, lbc_AL},
+	{0x1D360, 0x1D378, lbc_AL},
+	{0x1D400, 0x1D454, lbc_AL},
+	{0x1D456, 0x1D49C, lbc_AL},
+	{0x1D49E, 0x1D49F, lbc_AL},
+	{0x1D4A2, 0x1D4A2, lbc_AL},
+	{0x1D4A5, 0x1D4A6, lbc_AL},
+	{0x1D4A9, 0x1D4AC, lbc_AL},
+	{0x1D4AE, 0x1D4B9, lbc_AL},
+	{0x1D4BB, 0x1D4BB, lbc_AL},
+	{0x1D4BD, 0x1D4C3, lbc_AL},
+	{0x1D4C5, 0x1D505, lbc_AL},
+	{0x1D507, 0x1D50A, lbc_AL},
+	{0x1D50D, 0x1D514, lbc_AL},
+	{0x1D516, 0x1D51C, lbc_AL},
+	{0x1D51E, 0x1D539, lbc_AL},
+	{0x1D53B, 0x1D53E, lbc_AL},
+	{0x1D540, 0x1D544, lbc_AL},
+	{0x1D546, 0x1D546, lbc_AL},
+	{0x1D54A, 0x1D550, lbc_AL},
+	{0x1D552, 0x1D6A5, lbc_AL},
+	{0x1D6A8, 0x1D7CB, lbc_AL},
+	{0x1D7CE, 0x1D7FF, lbc_NU},
+	{0x1D800, 0x1D9FF, lbc_AL},
+	{0x1DA00, 0x1DA36, lbc_CM},
+	{0x1DA37, 0x1DA3A, lbc_AL},
+	{0x1DA3B, 0x1DA6C, lbc_CM},
+	{0x1DA6D, 0x1DA74, lbc_AL},
+	{0x1DA75, 0x1DA75, lbc_CM},
+	{0x1DA76, 0x1DA83, lbc_AL},
+	{0x1DA84, 0x1DA84, lbc_CM},
+	{0x1DA85, 0x1DA86, lbc_AL},
+	{0x1DA87, 0x1DA8A, lbc_BA},
+	{0x1DA8B, 0x1DA8B, lbc_AL},
+	{0x1DA9B, 0x1DA9F, lbc_CM},
+	{0x1DAA1, 0x1DAAF, lbc_CM},
+	{0x1DF00, 0x1DF1E, lbc_AL},
+	{0x1DF25, 0x1DF2A, lbc_AL},
+	{0x1E000, 0x1E006, lbc_CM},
+	{0x1E008, 0x1E018, lbc_CM},
+	{0x1E01B, 0x1E021, lbc_CM},
+	{0x1E023, 0x1E024, lbc_CM},
+	{0x1E026, 0x1E02A, lbc_CM},
+	{0x1E030, 0x1E06D, lbc_AL},
+	{0x1E08F, 0x1E08F, lbc_CM},
+	{0x1E100, 0x1E12C, lbc_AL},
+	{0x1E130, 0x1E136, lbc_CM},
+	{0x1E137, 0x1E13D, lbc_AL},
+	{0x1E140, 0x1E149, lbc_NU},
+	{0x1E14E, 0x1E14F, lbc_AL},
+	{0x1E290, 0x1E2AD, lbc_AL},
+	{0x1E2AE, 0x1E2AE, lbc_CM},
+	{0x1E2C0, 0x1E2EB, lbc_AL},
+	{0x1E2EC, 0x1E2EF, lbc_CM},
+	{0x1E2F0, 0x1E2F9, lbc_NU},
+	{0x1E2FF, 0x1E2FF, lbc_PR},
+	{0x1E4D0, 0x1E4EB, lbc_AL},
+	{0x1E4EC, 0x1E4EF, lbc_CM},
+	{0x1E4F0, 0x1E4F9, lbc_NU},
+	{0x1E5D0, 0x1E5ED, lbc_AL},
+	{0x1E5EE, 0x1E5EF, lbc_CM},
+	{0x1E5F0, 0x1E5F0, lbc_AL},
+	{0x1E5F1, 0x1E5FA, lbc_NU},
+	{0x1E5FF, 0x1E5FF, lbc_AL},
+	{0x1E6C0, 0x1E6DE, lbc_AL},
+	{0x1E6E0, 0x1E6E2, lbc_AL},
+	{0x1E6E3, 0x1E6E3, lbc_CM},
+	{0x1E6E4, 0x1E6E5, lbc_AL},
+	{0x1E6E6, 0x1E6E6, lbc_CM},
+	{0x1E6E7, 0x1E6ED, lbc_AL},
+	{0x1E6EE, 0x1E6EF, lbc_CM},
+	{0x1E6F0, 0x1E6F4, lbc_AL},
+	{0x1E6F5, 0x1E6F5, lbc_CM},
+	{0x1E6FE, 0x1E6FF, lbc_AL},
+	{0x1E7E0, 0x1E7E6, lbc_AL},
+	{0x1E7E8, 0x1E7EB, lbc_AL},
+	{0x1E7ED, 0x1E7EE, lbc_AL},
+	{0x1E7F0, 0x1E7FE, lbc_AL},
+	{0x1E800, 0x1E8C4, lbc_AL},
+	{0x1E8C7, 0x1E8CF, lbc_AL},
+	{0x1E8D0, 0x1E8D6, lbc_CM},
+	{0x1E900, 0x1E943, lbc_AL},
+	{0x1E944, 0x1E94A, lbc_CM},
+	{0x1E94B, 0x1E94B, lbc_AL},
+	{0x1E950, 0x1E959, lbc_NU},
+	{0x1E95E, 0x1E95F, lbc_OP},
+	{0x1EC71, 0x1ECAB, lbc_AL},
+	{0x1ECAC, 0x1ECAC, lbc_PO},
+	{0x1ECAD, 0x1ECAF, lbc_AL},
+	{0x1ECB0, 0x1ECB0, lbc_PO},
+	{0x1ECB1, 0x1ECB4, lbc_AL},
+	{0x1ED01, 0x1ED3D, lbc_AL},
+	{0x1EE00, 0x1EE03, lbc_AL},
+	{0x1EE05, 0x1EE1F, lbc_AL},
+	{0x1EE21, 0x1EE22, lbc_AL},
+	{0x1EE24, 0x1EE24, lbc_AL},
+	{0x1EE27, 0x1EE27, lbc_AL},
+	{0x1EE29, 0x1EE32, lbc_AL},
+	{0x1EE34, 0x1EE37, lbc_AL},
+	{0x1EE39, 0x1EE39, lbc_AL},
+	{0x1EE3B, 0x1EE3B, lbc_AL},
+	{0x1EE42, 0x1EE42, lbc_AL},
+	{0x1EE47, 0x1EE47, lbc_AL},
+	{0x1EE49, 0x1EE49, lbc_AL},
+	{0x1EE4B, 0x1EE4B, lbc_AL},
+	{0x1EE4D, 0x1EE4F, lbc_AL},
+	{0x1EE51, 0x1EE52, lbc_AL},
+	{0x1EE54, 0x1EE54, lbc_AL},
+	{0x1EE57, 0x1EE57, lbc_AL},
+	{0x1EE59, 0x1EE59, lbc_AL},
+	{0x1EE5B, 0x1EE5B, lbc_AL},
+	{0x1EE5D, 0x1EE5D, lbc_AL},
+	{0x1EE5F, 0x1EE5F, lbc_AL},
+	{0x1EE61, 0x1EE62, lbc_AL},
+	{0x1EE64, 0x1EE64, lbc_AL},
+	{0x1EE67, 0x1EE6A, lbc_AL},
+	{0x1EE6C, 0x1EE72, lbc_AL},
+	{0x1EE74, 0x1EE77, lbc_AL},
+	{0x1EE79, 0x1EE7C, lbc_AL},
+	{0x1EE7E, 0x1EE7E, lbc_AL},
+	{0x1EE80, 0x1EE89, lbc_AL},
+	{0x1EE8B, 0x1EE9B, lbc_AL},
+	{0x1EEA1, 0x1EEA3, lbc_AL},
+	{0x1EEA5, 0x1EEA9, lbc_AL},
+	{0x1EEAB, 0x1EEBB, lbc_AL},
+	{0x1EEF0, 0x1EEF1, lbc_AL},
+	{0x1F000, 0x1F0FF, lbc_ID},
+	{0x1F100, 0x1F10C, lbc_AI},
+	{0x1F10D, 0x1F10F, lbc_AL},
+	{0x1F110, 0x1F12D, lbc_AI},
+	{0x1F12E, 0x1F12F, lbc_AL},
+	{0x1F130, 0x1F169, lbc_AI},
+	{0x1F16A, 0x1F16F, lbc_AL},
+	{0x1F170, 0x1F1AC, lbc_AI},
+	{0x1F1AD, 0x1F1AD, lbc_AL},
+	{0x1F1AE, 0x1F1E5, lbc_ID},
+	{0x1F1E6, 0x1F1FF, lbc_RI},
+	{0x1F200, 0x1F384, lbc_ID},
+	{0x1F385, 0x1F385, lbc_EB},
+	{0x1F386, 0x1F39B, lbc_ID},
+	{0x1F39C, 0x1F39D, lbc_AL},
+	{0x1F39E, 0x1F3B4, lbc_ID},
+	{0x1F3B5, 0x1F3B6, lbc_AL},
+	{0x1F3B7, 0x1F3BB, lbc_ID},
+	{0x1F3BC, 0x1F3BC, lbc_AL},
+	{0x1F3BD, 0x1F3C1, lbc_ID},
+	{0x1F3C2, 0x1F3C4, lbc_EB},
+	{0x1F3C5, 0x1F3C6, lbc_ID},
+	{0x1F3C7, 0x1F3C7, lbc_EB},
+	{0x1F3C8, 0x1F3C9, lbc_ID},
+	{0x1F3CA, 0x1F3CC, lbc_EB},
+	{0x1F3CD, 0x1F3FA, lbc_ID},
+	{0x1F3FB, 0x1F3FF, lbc_EM},
+	{0x1F400, 0x1F441, lbc_ID},
+	{0x1F442, 0x1F443, lbc_EB},
+	{0x1F444, 0x1F445, lbc_ID},
+	{0x1F446, 0x1F450, lbc_EB},
+	{0x1F451, 0x1F465, lbc_ID},
+	{0x1F466, 0x1F478, lbc_EB},
+	{0x1F479, 0x1F47B, lbc_ID},
+	{0x1F47C, 0x1F47C, lbc_EB},
+	{0x1F47D, 0x1F480, lbc_ID},
+	{0x1F481, 0x1F483, lbc_EB},
+	{0x1F484, 0x1F484, lbc_ID},
+	{0x1F485, 0x1F487, lbc_EB},
+	{0x1F488, 0x1F48E, lbc_ID},
+	{0x1F48F, 0x1F48F, lbc_EB},
+	{0x1F490, 0x1F490, lbc_ID},
+	{0x1F491, 0x1F491, lbc_EB},
+	{0x1F492, 0x1F49F, lbc_ID},
+	{0x1F4A0, 0x1F4A0, lbc_AL},
+	{0x1F4A1, 0x1F4A1, lbc_ID},
+	{0x1F4A2, 0x1F4A2, lbc_AL},
+	{0x1F4A3, 0x1F4A3, lbc_ID},
+	{0x1F4A4, 0x1F4A4, lbc_AL},
+	{0x1F4A5, 0x1F4A9, lbc_ID},
+	{0x1F4AA, 0x1F4AA, lbc_EB},
+	{0x1F4AB, 0x1F4AE, lbc_ID},
+	{0x1F4AF, 0x1F4AF, lbc_AL},
+	{0x1F4B0, 0x1F4B0, lbc_ID},
+	{0x1F4B1, 0x1F4B2, lbc_AL},
+	{0x1F4B3, 0x1F4FF, lbc_ID},
+	{0x1F500, 0x1F506, lbc_AL},
+	{0x1F507, 0x1F516, lbc_ID},
+	{0x1F517, 0x1F524, lbc_AL},
+	{0x1F525, 0x1F531, lbc_ID},
+	{0x1F532, 0x1F549, lbc_AL},
+	{0x1F54A, 0x1F573, lbc_ID},
+	{0x1F574, 0x1F575, lbc_EB},
+	{0x1F576, 0x1F579, lbc_ID},
+	{0x1F57A, 0x1F57A, lbc_EB},
+	{0x1F57B, 0x1F58F, lbc_ID},
+	{0x1F590, 0x1F590, lbc_EB},
+	{0x1F591, 0x1F594, lbc_ID},
+	{0x1F595, 0x1F596, lbc_EB},
+	{0x1F597, 0x1F5D3, lbc_ID},
+	{0x1F5D4, 0x1F5DB, lbc_AL},
+	{0x1F5DC, 0x1F5F3, lbc_ID},
+	{0x1F5F4, 0x1F5F9, lbc_AL},
+	{0x1F5FA, 0x1F644, lbc_ID},
+	{0x1F645, 0x1F647, lbc_EB},
+	{0x1F648, 0x1F64A, lbc_ID},
+	{0x1F64B, 0x1F64F, lbc_EB},
+	{0x1F650, 0x1F675, lbc_AL},
+	{0x1F676, 0x1F678, lbc_QU},
+	{0x1F679, 0x1F67B, lbc_NS},
+	{0x1F67C, 0x1F67F, lbc_AL},
+	{0x1F680, 0x1F6A2, lbc_ID},
+	{0x1F6A3, 0x1F6A3, lbc_EB},
+	{0x1F6A4, 0x1F6B3, lbc_ID},
+	{0x1F6B4, 0x1F6B6, lbc_EB},
+	{0x1F6B7, 0x1F6BF, lbc_ID},
+	{0x1F6C0, 0x1F6C0, lbc_EB},
+	{0x1F6C1, 0x1F6CB, lbc_ID},
+	{0x1F6CC, 0x1F6CC, lbc_EB},
+	{0x1F6CD, 0x1F6FF, lbc_ID},
+	{0x1F700, 0x1F773, lbc_AL},
+	{0x1F774, 0x1F776, lbc_ID},
+	{0x1F777, 0x1F77A, lbc_AL},
+	{0x1F77B, 0x1F77F, lbc_ID},
+	{0x1F780, 0x1F7D4, lbc_AL},
+	{0x1F7D5, 0x1F7FF, lbc_ID},
+	{0x1F800, 0x1F80B, lbc_AL},
+	{0x1F810, 0x1F847, lbc_AL},
+	{0x1F850, 0x1F859, lbc_AL},
+	{0x1F860, 0x1F887, lbc_AL},
+	{0x1F890, 0x1F8AD, lbc_AL},
+	{0x1F8B0, 0x1F8BB, lbc_AL},
+	{0x1F8C0, 0x1F8C1, lbc_AL},
+	{0x1F8D0, 0x1F8D8, lbc_AL},
+	{0x1F900, 0x1F90B, lbc_AL},
+	{0x1F90C, 0x1F90C, lbc_EB},
+	{0x1F90D, 0x1F90E, lbc_ID},
+	{0x1F90F, 0x1F90F, lbc_EB},
+	{0x1F910, 0x1F917, lbc_ID},
+	{0x1F918, 0x1F91F, lbc_EB},
+	{0x1F920, 0x1F925, lbc_ID},
+	{0x1F926, 0x1F926, lbc_EB},
+	{0x1F927, 0x1F92F, lbc_ID},
+	{0x1F930, 0x1F939, lbc_EB},
+	{0x1F93A, 0x1F93B, lbc_ID},
+	{0x1F93C, 0x1F93E, lbc_EB},
+	{0x1F93F, 0x1F976, lbc_ID},
+	{0x1F977, 0x1F977, lbc_EB},
+	{0x1F978, 0x1F9B4, lbc_ID},
+	{0x1F9B5, 0x1F9B6, lbc_EB},
+	{0x1F9B7, 0x1F9B7, lbc_ID},
+	{0x1F9B8, 0x1F9B9, lbc_EB},
+	{0x1F9BA, 0x1F9BA, lbc_ID},
+	{0x1F9BB, 0x1F9BB, lbc_EB},
+	{0x1F9BC, 0x1F9CC, lbc_ID},
+	{0x1F9CD, 0x1F9CF, lbc_EB},
+	{0x1F9D0, 0x1F9D0, lbc_ID},
+	{0x1F9D1, 0x1F9DD, lbc_EB},
+	{0x1F9DE, 0x1F9FF, lbc_ID},
+	{0x1FA00, 0x1FA57, lbc_AL},
+	{0x1FA58, 0x1FAC2, lbc_ID},
+	{0x1FAC3, 0x1FAC5, lbc_EB},
+	{0x1FAC6, 0x1FAEF, lbc_ID},
+	{0x1FAF0, 0x1FAF8, lbc_EB},
+	{0x1FAF9, 0x1FAFF, lbc_ID},
+	{0x1FB00, 0x1FB92, lbc_AL},
+	{0x1FB94, 0x1FBEF, lbc_AL},
+	{0x1FBF0, 0x1FBF9, lbc_NU},
+	{0x1FBFA, 0x1FBFA, lbc_AL},
+	{0x1FC00, 0x1FFFD, lbc_ID},
+	{0x20000, 0x2FFFD, lbc_ID},
+	{0x30000, 0x3FFFD, lbc_ID},
+	{0xE0001, 0xE0001, lbc_CM},
+	{0xE0020, 0xE007F, lbc_CM},
+	{0xE0100, 0xE01EF, lbc_CM},
+}
@@ -5,8 +5,72 @@
 package linebreak
 
 var lboBreaks = []rune{
-	0x0a, // LF
-	0x0d, // CR
+	0x0a,   // LF
+	0x0b,   // VT
+	0x0c,   // FF
+	0x0d,   // CR
+	0x85,   // NEL
+	0x2028, // LINE SEPARATOR
+	0x2029, // PARAGRAPH SEPARATOR
+}
+
+// The runes which are displayed as spaces but glue the runes before and after
+// them.
+var lboGlues = []rune{
+	0xa0,   // NO-BREAK SPACE
+	0x2007, // FIGURE SPACE
+	0x202f, // NARROW NO-BREAK SPACE
+}
+
+// The invisible runes which forbid line breaks before and after them.
+var lboJoiners = []rune{
+	0x2060, // WORD JOINER
+	0xfeff, // ZERO WIDTH NO-BREAK SPACE
+}
+
+// The invisible rune which is a line break opportunity.
+const zeroWidthSpace = 0x200b
+
+// The hyphens and dashes after which a line can be broken, if they follow a
+// letter or a digit.
+var lboHyphens = []rune{
+	0x002D, // -
+	0x2010, // ‐ HYPHEN
+	0x2012, // ‒ FIGURE DASH
+	0x2013, // – EN DASH
+}
+
+// The em dashes around which a line can be broken by the rules.
+var lboEmDashes = []rune{
+	0x2014, // — EM DASH
+	0x2E3A, // ⸺ TWO-EM DASH
+	0x2E3B, // ⸻ THREE-EM DASH
+}
+
+// The runes which separate digits in a number, such as a decimal point and a
+// thousands separator.
+var numSeparators = []rune{
+	0x002C, // ,
+	0x002E, // .
+	0x002F, // /
+	0x003A, // :
+	0xFF0C, // ，
+	0xFF0E, // ．
+	0xFF0F, // ／
+	0xFF1A, // ：
+}
+
+// The runes which follow a number, other than currency symbols.
+var numPostfixes = []rune{
+	0x0025, // %
+	0x00B0, // °
+	0x2030, // ‰
+	0x2031, // ‱
+	0x2032, // ′
+	0x2033, // ″
+	0x2103, // ℃
+	0x2109, // ℉
+	0xFF05, // ％
 }
 
 // https://en.wikipedia.org/wiki/Line_breaking_rules_in_East_Asian_languages
@@ -81,3 +145,37 @@ var lboAfters = []rune{
 	0xFF09, // )
 	0xFF5D, // ｝
 }
+
+// The punctuations which can hang beyond the end of a line with burasage.
+const hangingPuncts = "、。，．,.｡､"
+
+// The runes for the presets of Rules.
+// Each string lists runes which cannot be at the head of a line (noBreakBefore)
+// or at the end of a line (noBreakAfter).
+
+const (
+	asciiNoBreakBefore = ")]},.:;?!"
+	asciiNoBreakAfter  = "([{"
+
+	// JIS X 4051 line start prohibited characters, excluding small kana,
+	// iteration marks and prolonged sound marks for the loose rules.
+	jaLooseNoBreakBefore = asciiNoBreakBefore +
+		"）］｝〉》」』】〕〗〙〟’”｠»、。，．・：；？！‼⁇⁈⁉｡｣､･"
+	jaStrictNoBreakBefore = jaLooseNoBreakBefore +
+		"ぁぃぅぇぉっゃゅょゎゕゖァィゥェォッャュョヮヵヶㇰㇱㇲㇳㇴㇵㇶㇷㇸㇹㇺㇻㇼㇽㇾㇿ" +
+		"ｧｨｩｪｫｬｭｮｯ" + "ーｰ々〻ゝゞヽヾ゛゜" + "‐゠–〜～"
+	jaNoBreakAfter = asciiNoBreakAfter + "（［｛〔〈《「『【〘〖〝‘“｟«｢"
+
+	// GB/T 15834 for Simplified Chinese.
+	zhHansNoBreakBefore = asciiNoBreakBefore + "%" +
+		"！％），．：；？］｝、。〉》」』】〕〗’”…‥・·～"
+	zhHansNoBreakAfter = asciiNoBreakAfter + "$" + "（［｛〈《「『【〔〖‘“￥＄"
+
+	// The rules for Traditional Chinese, including the small form variants.
+	zhHantNoBreakBefore = asciiNoBreakBefore +
+		"！），．：；？］｝、。〉》」』】〕〗〞’”…‧﹐﹑﹒﹔﹕﹖﹗﹚﹜﹞"
+	zhHantNoBreakAfter = asciiNoBreakAfter + "（［｛〈《「『【〔〖〝‘“﹙﹛﹝"
+
+	koNoBreakBefore = asciiNoBreakBefore + "）］｝〉》」』】〕’”、。，．"
+	koNoBreakAfter  = asciiNoBreakAfter + "（［｛〈《「『【〔‘“"
+)
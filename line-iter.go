@@ -5,6 +5,7 @@
 package linebreak
 
 import (
+	"io"
 	"strings"
 	"text/scanner"
 	"unicode"
@@ -22,64 +23,566 @@ const (
 	lbo_both
 	lbo_break
 	lbo_space
+	lbo_glue
+	lbo_joiner
 )
 
 type lboState struct {
-	lboType  lboType
-	lboPrev  lboType
-	openApos int8 // 0:not, 1:opened, 2:opened inside "..."
-	openQuot int8 // 0:not, 1:opened, 2:opened inside '...'
+	lboType      lboType
+	lboPrev      lboType
+	quotes       []rune // the open quotes from the outermost
+	pendingQuote int    // 1 + index of the quote closed or not by the previous rune
+	rules        Rules
+	prev         [2]rune // the previous rune and the rune before it
+}
+
+// Line break opportunity between the previous rune and the current rune.
+type brkType int
+
+const (
+	brk_never     brkType = iota // no line break before the current rune
+	brk_allowed                  // a line break is allowed before the rune
+	brk_mandatory                // the current rune is a line terminator
+	brk_space                    // the current rune is a space
+)
+
+// lboFinder is the interface to find line break opportunities.
+// The find method receives runes one by one, and appends the line break
+// opportunities before the runes, which are determined by then, to brks in
+// order. Since an opportunity can be determined by the following runes, the
+// opportunities can be appended some runes later, and the flush method
+// appends the rest of them at the end of text.
+type lboFinder interface {
+	find(r rune, brks []brkType) []brkType
+	flush(brks []brkType) []brkType
+	reset()
+	setRules(rules Rules)
+}
+
+// BreakAlgorithm is the enum type for algorithms to find line break
+// opportunities.
+type BreakAlgorithm int
+
+const (
+	// BreakBasic is the algorithm which finds line break opportunities with
+	// spaces, East Asian wide letters and a small set of punctuation rules for
+	// English and Japanese.
+	// This is the default algorithm.
+	BreakBasic BreakAlgorithm = iota
+
+	// BreakUAX14 is the algorithm which finds line break opportunities with
+	// the Unicode Line Breaking Algorithm (Unicode Standard Annex #14).
+	BreakUAX14
+)
+
+// Kind of a line, which determines the indentation of the line.
+type lineKind int
+
+const (
+	line_first        lineKind = iota // the first line of the text
+	line_continuation                 // a line after a line break by wrapping
+	line_paragraph                    // a line after a mandatory line break
+)
+
+// Kind of the end of a line.
+type lineEnd int
+
+const (
+	end_wrap      lineEnd = iota // a line broken by wrapping
+	end_paragraph                // a line ended by a mandatory break or EOT
+	end_hyphen                   // a line broken in a word with a hyphen
+)
+
+// indentText is the struct that holds an indentation and its display width.
+type indentText struct {
+	text  string
+	width int
+	isSet bool
+}
+
+// LineInfo is the struct that holds the metadata of a line, which is passed
+// to the functions set by SetPrefixFunc and SetSuffixFunc.
+type LineInfo struct {
+	// LineNumber is the 1-based number of the line in the text.
+	LineNumber int
+
+	// ParagraphNumber is the 1-based number of the paragraph which the line
+	// belongs to. A paragraph is a part of the text separated by mandatory line
+	// breaks.
+	ParagraphNumber int
+
+	// IsContinuation indicates whether the line follows a line broken by
+	// wrapping.
+	IsContinuation bool
+}
+
+// Line is the struct that holds an output line and its metadata, which is
+// returned by NextLine.
+type Line struct {
+	// Text is the text of the line, including its indentation, prefix and
+	// suffix.
+	Text string
+
+	// Width is the display width of Text.
+	Width int
+
+	// Start and End are the byte offsets in the source text of the first rune
+	// of the line and of the position just after the last visible rune of the
+	// line. For a line without visible runes, Start and End are the same.
+	Start, End int
+
+	// RuneStart and RuneEnd are the rune offsets in the source text which
+	// correspond to Start and End.
+	RuneStart, RuneEnd int
+
+	// HardBreak indicates whether the line ended with a line separator or at
+	// the end of the text, not by wrapping.
+	HardBreak bool
+
+	// Forced indicates whether the line was broken forcely in the middle of a
+	// word because there was no line break opportunity nor hyphenation point
+	// in the line.
+	Forced bool
+
+	// Separator is the line separator which ended the line. See Separator.
+	Separator string
+}
+
+// lineMeta is the struct that holds the metadata of an output line, which is
+// recorded when the line is determined.
+type lineMeta struct {
+	sep    string /* the line separator which ended the line */
+	width  int
+	start  srcPos
+	end    srcPos
+	hard   bool
+	forced bool
+}
+
+// lineDecor is the struct that holds the prefix and suffix of a line and
+// their display width.
+type lineDecor struct {
+	prefix string
+	suffix string
+	width  int
+	isSet  bool
 }
 
 // LineIter is the struct that outputs the given string line by line.
 // This struct can control the overall line width and the indentation from any
 // desired line.
 type LineIter struct {
-	scanner     *scanner.Scanner
-	isEnd       bool
-	buffer      runeBuffer
-	width       [2]int /* 0: width before lbo, 1: width after lbo */
-	lboPos      int
-	limit       int
-	indent      string
-	indentWidth int
-	openQuot    int8
-	openApos    int8
+	scanner      *scanner.Scanner
+	reader       *errReader
+	isEnd        bool
+	buffer       runeBuffer
+	width        [3]int /* 0: width before lbo, 1: width after lbo, 2: width of trailing spaces */
+	lboPos       int
+	limit        int
+	indent       indentText
+	indents      [3]indentText /* indexed by lineKind */
+	lineKind     lineKind
+	prefixFn     func(LineInfo) string
+	suffixFn     func(LineInfo) string
+	decor        lineDecor
+	lineNo       int
+	paraNo       int
+	isEmpty      bool /* whether the last output line has no content */
+	align        Alignment
+	hyphenator   *Hyphenator
+	forcedHyphen bool
+	lboHyphen    bool /* whether a hyphen is put when broken at lboPos */
+	inWord       bool
+	wordStart    int
+	hyphens      []int    /* hyphenation points of the last word in the buffer */
+	pendings     []string /* lines which are determined but not output yet */
+	fillMode     FillMode
+	penalties    Penalties
+	cands        []fitCand
+	wordStartW   int
+	tabMode      TabMode
+	tabWidth     int
+	whitespace   WhitespaceMode
+	rules        Rules
+	hasRules     bool
+	kinsoku      KinsokuStrategy
+	hangW        int /* width of the runes which hang beyond the line end */
+	linkAware    bool
+	link         linkState
+	leadDone     bool       /* whether the leading spaces of the paragraph have ended */
+	sep          string     /* the line separator which ends the current line */
+	metas        []lineMeta /* metadata of the lines which are not output yet */
+	lastMeta     lineMeta   /* metadata of the line last output */
+	measurer     WidthMeasurer
+	finder       lboFinder
+	ahead        aheadQueue /* runes waiting for line break opportunities */
+	grapheme     graphemeState
+	cluster      []rune
+	clusterBrk   brkType
+	escSeq       escSeqState
+	escRunes     []rune
+	escLen       int      /* rune count of the escape sequences at the end of buffer */
+	clusterSrcs  []srcPos /* positions of the runes of cluster in the source */
+	escSrcs      []srcPos /* positions of the runes of escRunes in the source */
+	srcOffset    int      /* byte offset of the next rune in the source */
+	srcIndex     int      /* rune offset of the next rune in the source */
+	forced       bool     /* whether the line being determined is broken forcely */
+	sgr          sgrState
 }
 
 // New is the function that creates a LineIter instance which outputs the given
 // string line by line.
-// The second arguument is the width of the output lines.
-func New(text string, lineWidth int) LineIter {
-	sc := new(scanner.Scanner)
-	sc.Init(strings.NewReader(text))
+// The second arguument is the width of the output lines, and the options are
+// applied to the created instance in order.
+func New(text string, lineWidth int, opts ...Option) LineIter {
+	return NewReader(strings.NewReader(text), lineWidth, opts...)
+}
 
+// NewReader is the function that creates a LineIter instance which outputs the
+// text read from the given io.Reader line by line.
+// The text is read incrementally while iterating, so that a large text can be
+// processed without loading it into memory at once.
+// The second arguument is the width of the output lines, and the options are
+// applied to the created instance in order.
+// If an error occurs while reading, the iteration ends and the error can be
+// obtained by the Err method.
+func NewReader(r io.Reader, lineWidth int, opts ...Option) LineIter {
 	iter := LineIter{}
-	iter.scanner = sc
+	iter.scanner = new(scanner.Scanner)
+	iter.reader = new(errReader)
+	iter.initReader(r)
 	iter.buffer = newRuneBuffer(lineWidth)
 	iter.limit = lineWidth
+	iter.finder = &lboState{rules: defaultRules}
+	iter.penalties = DefaultPenalties()
+	iter.tabWidth = defaultTabWidth
+	for _, opt := range opts {
+		opt(&iter)
+	}
 	return iter
 }
 
 // SetIndent is the method to set an indentation for the subsequent lines.
+// This indentation is applied to the lines of which the kind, the first line,
+// a continuation line or a paragraph start line, has no specific indentation
+// set by SetFirstIndent, SetContinuationIndent or SetParagraphIndent.
 func (iter *LineIter) SetIndent(indent string) {
-	iter.indent = indent
-	iter.indentWidth = TextWidth(indent)
+	iter.indent = iter.newIndent(indent)
+}
+
+// SetFirstIndent is the method to set an indentation for the first line of
+// the text.
+// A text for usage output, such as an option name, can be set as this
+// indentation, and the following lines can be aligned after it with
+// SetContinuationIndent.
+func (iter *LineIter) SetFirstIndent(indent string) {
+	iter.indents[line_first] = iter.newIndent(indent)
+}
+
+// SetContinuationIndent is the method to set an indentation for the lines
+// which follow a line broken by wrapping, that is, a hanging indent.
+func (iter *LineIter) SetContinuationIndent(indent string) {
+	iter.indents[line_continuation] = iter.newIndent(indent)
+}
+
+// SetParagraphIndent is the method to set an indentation for the lines which
+// follow a mandatory line break, such as a line feed.
+func (iter *LineIter) SetParagraphIndent(indent string) {
+	iter.indents[line_paragraph] = iter.newIndent(indent)
+}
+
+func (iter *LineIter) newIndent(indent string) indentText {
+	return indentText{
+		text:  indent,
+		width: iter.measurer.TextWidth(indent),
+		isSet: true,
+	}
+}
+
+// currentIndent is the method that returns the indentation of the line which
+// is being processed.
+func (iter *LineIter) currentIndent() indentText {
+	return iter.indentOf(iter.lineKind)
+}
+
+// indentOf is the method that returns the indentation of the specified kind
+// of lines.
+func (iter *LineIter) indentOf(kind lineKind) indentText {
+	if ind := iter.indents[kind]; ind.isSet {
+		return ind
+	}
+	return iter.indent
+}
+
+// SetAlignment is the method to set the alignment of the subsequent lines.
+// If a suffix is set by SetSuffixFunc and the alignment is not AlignLeft,
+// the lines are padded with spaces so that the suffixes are aligned.
+func (iter *LineIter) SetAlignment(align Alignment) {
+	iter.align = align
+}
+
+// SetHyphenator is the method to set a Hyphenator to hyphenate a word which
+// overflows a line.
+// If the argument is nil, words are not hyphenated.
+// Soft hyphens (U+00AD) in the text are used as hyphenation points regardless
+// of this setting.
+func (iter *LineIter) SetHyphenator(h *Hyphenator) {
+	iter.hyphenator = h
+}
+
+// SetForcedBreakHyphen is the method to set whether a hyphen is put at the end
+// of a line which is broken forcely in a word because there is no line break
+// opportunity nor hyphenation point.
+func (iter *LineIter) SetForcedBreakHyphen(on bool) {
+	iter.forcedHyphen = on
+}
+
+// SetFillMode is the method to set the way to choose line breaks in a
+// paragraph.
+// This method should be called before the first call of Next.
+func (iter *LineIter) SetFillMode(mode FillMode) {
+	iter.fillMode = mode
+}
+
+// SetPenalties is the method to set the penalties which are used to choose
+// line breaks in FillOptimal mode.
+func (iter *LineIter) SetPenalties(p Penalties) {
+	iter.penalties = p
+}
+
+// SetTabMode is the method to set the way to handle horizontal tabs in the
+// text.
+// In TabExpand and TabPreserve modes, a tab has the width up to the next tab
+// stop, which is counted from the head of the line including its
+// indentation, but excluding its prefix.
+// In FillOptimal mode, the widths of tabs are computed for each candidate of
+// a line, so that a line which has tabs fits the line width as well.
+func (iter *LineIter) SetTabMode(mode TabMode) {
+	iter.tabMode = mode
+}
+
+// SetTabWidth is the method to set the interval of tab stops.
+// The default interval is 8. If the argument is not positive, the default
+// interval is used.
+func (iter *LineIter) SetTabWidth(width int) {
+	if width <= 0 {
+		width = defaultTabWidth
+	}
+	iter.tabWidth = width
+}
+
+// SetWhitespaceMode is the method to set the way to handle white spaces in
+// the text.
+// With WhitespacePreserve or WhitespacePreserveLeading, the spaces at the head
+// of each paragraph are kept, so that a preformatted paragraph, such as a
+// code block, can be wrapped without losing its indentation.
+func (iter *LineIter) SetWhitespaceMode(mode WhitespaceMode) {
+	iter.whitespace = mode
+}
+
+// SetPrefixFunc is the method to set a function which returns a prefix of
+// each line, such as a comment leader, a quote marker or a line number.
+// The function receives the metadata of the line, and the width of the
+// returned prefix is subtracted from the width of the line.
+// The prefix is put before the indentation of the line.
+func (iter *LineIter) SetPrefixFunc(fn func(info LineInfo) string) {
+	iter.prefixFn = fn
+	iter.decor.isSet = false
+}
+
+// SetSuffixFunc is the method to set a function which returns a suffix of
+// each line.
+// The function receives the metadata of the line, and the width of the
+// returned suffix is subtracted from the width of the line.
+func (iter *LineIter) SetSuffixFunc(fn func(info LineInfo) string) {
+	iter.suffixFn = fn
+	iter.decor.isSet = false
+}
+
+// lineLimit is the method that returns the width of the line which is being
+// processed, excluding its indentation, prefix and suffix.
+func (iter *LineIter) lineLimit() int {
+	iter.decorate()
+	return iter.limit - iter.currentIndent().width - iter.decor.width
+}
+
+// decorate is the method to determine the prefix and suffix of the line which
+// is being processed, if they are not determined yet.
+func (iter *LineIter) decorate() {
+	if iter.decor.isSet {
+		return
+	}
+
+	info := LineInfo{
+		LineNumber:      iter.lineNo + 1,
+		ParagraphNumber: iter.paraNo,
+		IsContinuation:  (iter.lineKind == line_continuation),
+	}
+	if !info.IsContinuation {
+		info.ParagraphNumber++
+	}
+
+	d := lineDecor{isSet: true}
+	if iter.prefixFn != nil {
+		d.prefix = iter.prefixFn(info)
+	}
+	if iter.suffixFn != nil {
+		d.suffix = iter.suffixFn(info)
+	}
+	d.width = iter.measurer.TextWidth(d.prefix) + iter.measurer.TextWidth(d.suffix)
+	iter.decor = d
+}
+
+// SetWidthMeasurer is the method to set the WidthMeasurer which measures the
+// display widths of the indentation and the runes in the text.
+// This method should be called before the first call of Next.
+func (iter *LineIter) SetWidthMeasurer(m WidthMeasurer) {
+	iter.measurer = m
+	iter.indent.width = m.TextWidth(iter.indent.text)
+	for i := range iter.indents {
+		iter.indents[i].width = m.TextWidth(iter.indents[i].text)
+	}
+}
+
+// SetBreakAlgorithm is the method to set the algorithm to find line break
+// opportunities.
+// This method should be called before the first call of Next.
+func (iter *LineIter) SetBreakAlgorithm(alg BreakAlgorithm) {
+	switch alg {
+	case BreakUAX14:
+		iter.finder = &uax14State{rules: iter.rules}
+	default:
+		rules := defaultRules
+		if iter.hasRules {
+			rules = iter.rules
+		}
+		iter.finder = &lboState{rules: rules}
+	}
+}
+
+// SetKinsokuStrategy is the method to set the way to avoid a rune which
+// cannot be at the head of a line when the rune overflows a line.
+// With KinsokuBurasage or KinsokuOikomi, a line can exceed the line width by
+// the width of one rune hanging or pushed in. These strategies are used in
+// FillGreedy mode.
+func (iter *LineIter) SetKinsokuStrategy(strategy KinsokuStrategy) {
+	iter.kinsoku = strategy
+}
+
+// SetLinkAware is the method to enable or disable the link aware mode.
+// In this mode, URLs, e-mail addresses and file paths in the text are moved
+// to the next line as a whole instead of being broken in them.
+// A URL or a file path which is wider than a line is broken only after
+// slashes or before '?', '#' and '&', and no hyphen is put at the break, so
+// that it can be copied and pasted. An e-mail address which is wider than a
+// line is broken forcely at the line width.
+// Each token is read to its end before it is put into a line, so that it is
+// classified as a whole.
+func (iter *LineIter) SetLinkAware(on bool) {
+	iter.linkAware = on
+	iter.endLink()
+}
+
+// SetRules is the method to set the rules which tailor line break
+// opportunities for a language, such as kinsoku rules of Japanese.
+// With BreakBasic, the rules replace DefaultRules. With BreakUAX14, the rules
+// prohibit line breaks in addition to the Unicode Line Breaking Algorithm.
+// This method should be called before the first call of Next.
+func (iter *LineIter) SetRules(rules Rules) {
+	iter.rules = rules
+	iter.hasRules = true
+	iter.finder.setRules(rules)
 }
 
 // Init is the method to re-initialize with an argument string for reusing this
 // instance.
 func (iter *LineIter) Init(text string) {
-	iter.scanner.Init(strings.NewReader(text))
-	iter.buffer.length = 0
-	iter.width[0] = 0
-	iter.width[1] = 0
-	iter.lboPos = 0
-	iter.openQuot = 0
-	iter.openApos = 0
+	iter.InitReader(strings.NewReader(text))
+}
+
+// InitReader is the method to re-initialize with an argument io.Reader for
+// reusing this instance.
+func (iter *LineIter) InitReader(r io.Reader) {
+	iter.initReader(r)
+	iter.reset()
+	iter.lineNo = 0
+	iter.paraNo = 0
+	iter.srcOffset = 0
+	iter.srcIndex = 0
+}
+
+func (iter *LineIter) reset() {
+	iter.clearBuffer()
+	iter.pendings = iter.pendings[:0]
+	iter.sep = ""
+	iter.metas = iter.metas[:0]
+	iter.lastMeta = lineMeta{}
+	iter.finder.reset()
+	iter.ahead.reset()
+	iter.grapheme.reset()
+	iter.cluster = iter.cluster[:0]
+	iter.clusterSrcs = iter.clusterSrcs[:0]
+	iter.escSeq.reset()
+	iter.escRunes = iter.escRunes[:0]
+	iter.escSrcs = iter.escSrcs[:0]
+	iter.forced = false
+	iter.sgr.reset()
+	iter.lineKind = line_first
+	iter.decor.isSet = false
 	iter.isEnd = false
 }
 
+// Separator is the method that returns the line separator which ended the
+// line last returned by Next, such as "\n", "\r\n", "\v", "\f", "\u0085",
+// "\u2028" or "\u2029".
+// If the line was broken by wrapping or ended at the end of the text, this
+// method returns an empty string.
+func (iter LineIter) Separator() string {
+	return iter.lastMeta.sep
+}
+
+// popLineMeta is the method to take the metadata of the line which is output
+// next.
+func (iter *LineIter) popLineMeta() {
+	if len(iter.metas) > 0 {
+		iter.lastMeta = iter.metas[0]
+		iter.metas = iter.metas[1:]
+	}
+}
+
+// Err is the method that returns the first error which occurred while reading
+// the text, except io.EOF.
+func (iter LineIter) Err() error {
+	return iter.reader.err
+}
+
+func (iter *LineIter) initReader(r io.Reader) {
+	iter.reader.r = r
+	iter.reader.err = nil
+	iter.scanner.Init(iter.reader)
+	// The read error is held by errReader and invalid UTF-8 encodings are read
+	// as U+FFFD, so the scanner does not need to report them.
+	iter.scanner.Error = func(*scanner.Scanner, string) {}
+}
+
+// errReader is the struct that wraps an io.Reader and holds the first error
+// returned from it, except io.EOF.
+type errReader struct {
+	r   io.Reader
+	err error
+}
+
+func (er *errReader) Read(p []byte) (int, error) {
+	if er.err != nil {
+		return 0, er.err
+	}
+	n, err := er.r.Read(p)
+	if err != nil && err != io.EOF {
+		er.err = err
+	}
+	return n, err
+}
+
 func (iter LineIter) HasNext() bool {
 	return !iter.isEnd
 }
@@ -91,188 +594,901 @@ func (iter *LineIter) Next() (string, bool) {
 		return "", false
 	}
 
-	limit := iter.limit - iter.indentWidth
+	line := iter.next()
+	iter.popLineMeta()
+	return line, true
+}
 
-	if iter.width[0] > limit {
-		diff := iter.width[0] - limit
-		iter.width[0] = diff
-		for i := iter.buffer.length - 1; i >= 0; i-- {
-			r := iter.buffer.runes[i]
-			runeW := RuneWidth(r)
-			if diff <= runeW {
-				line := string(trimRight(iter.buffer.runes[0:i]))
-				iter.buffer.cr(i)
-				if len(line) > 0 {
-					line = iter.indent + line
-				}
-				return line, true
-			}
-			diff -= runeW
-		}
-	} else if iter.width[0] == limit {
-		iter.width[0] = 0
-		line := string(trimRight(iter.buffer.runes))
-		iter.buffer.cr(0)
-		if len(line) > 0 {
-			line = iter.indent + line
+// NextLine is the method that returns the next line with its metadata, such
+// as the display width and the offsets in the source text, and a bool which
+// indicates whether the returned line exists.
+func (iter *LineIter) NextLine() (Line, bool) {
+	text, exists := iter.Next()
+	if !exists {
+		return Line{}, false
+	}
+
+	m := iter.lastMeta
+	return Line{
+		Text:      text,
+		Width:     m.width,
+		Start:     m.start.offset,
+		End:       m.end.offset,
+		RuneStart: m.start.index,
+		RuneEnd:   m.end.index,
+		HardBreak: m.hard,
+		Forced:    m.forced,
+		Separator: m.sep,
+	}, true
+}
+
+func (iter *LineIter) next() string {
+	limit := iter.lineLimit()
+
+	if line, exists := iter.pendingLine(limit); exists {
+		return line
+	}
+	if line, exists := iter.process(limit); exists {
+		return line
+	}
+
+	for r := iter.scanner.Next(); r != scanner.EOF; r = iter.scanner.Next() {
+		size := iter.scanner.Pos().Offset - iter.srcOffset
+		if line, exists := iter.feed(r, size, limit); exists {
+			return line
 		}
+	}
+
+	return iter.flush(limit)
+}
+
+// pendingLine is the method that returns a line which is already determined,
+// or cuts the head of the buffer by the width if the content carried from the
+// previous line is wider than the limit.
+func (iter *LineIter) pendingLine(limit int) (string, bool) {
+	if len(iter.pendings) > 0 {
+		line := iter.pendings[0]
+		iter.pendings = iter.pendings[1:]
 		return line, true
 	}
+	if iter.fillMode == FillGreedy && iter.width[0]+iter.width[1] > limit {
+		return iter.cutByWidth(limit), true
+	}
+	return "", false
+}
+
+// feed is the method to read the specified rune of the text, of which the
+// byte length in the source text is size, and to process the runes of which
+// the line break opportunities are determined.
+// If a line is determined by the runes, this method returns the line and true.
+func (iter *LineIter) feed(r rune, size, limit int) (string, bool) {
+	src := srcPos{offset: iter.srcOffset, index: iter.srcIndex, size: size}
+	iter.srcOffset += size
+	iter.srcIndex++
+
+	if inSeq, ended := iter.escSeq.next(r); inSeq {
+		iter.ahead.push(aheadRune{r: r, src: src, esc: true, ended: ended})
+	} else {
+		iter.ahead.push(aheadRune{r: r, src: src})
+		iter.ahead.brks = iter.finder.find(r, iter.ahead.brks)
+	}
+
+	return iter.process(limit)
+}
+
+// process is the method to process the runes which have been read in order,
+// while the line break opportunities before them are determined.
+// In the link aware mode, the runes are also kept until the token including
+// them is read to its end, so that the token is classified as a whole.
+// If a line is determined by a rune, this method returns the line and true,
+// and the following runes are processed by the next call.
+// While a rune is processed, it is left at the head of ahead, and the runes
+// following it can be read from there.
+func (iter *LineIter) process(limit int) (string, bool) {
+	for !iter.ahead.isEmpty() {
+		a, ok := iter.ahead.first()
+		if !ok {
+			break
+		}
+		if !a.esc && iter.linkAware && !iter.ahead.tokenRead() {
+			break
+		}
+
+		var line string
+		var exists bool
+		if a.esc {
+			line, exists = iter.processEscRune(a, limit)
+		} else {
+			line, exists = iter.processRune(a, iter.ahead.firstBrk(), limit)
+		}
+		iter.ahead.pop()
+
+		if exists {
+			return line, true
+		}
+	}
+	return "", false
+}
+
+func (iter *LineIter) processEscRune(a aheadRune, limit int) (string, bool) {
+	iter.escRunes = append(iter.escRunes, a.r)
+	iter.escSrcs = append(iter.escSrcs, a.src)
+	if !a.ended {
+		return "", false
+	}
 
 	var line string
+	var exists bool
+	if len(iter.cluster) > 0 {
+		line, exists = iter.addCluster(limit)
+		iter.cluster = iter.cluster[:0]
+		iter.clusterSrcs = iter.clusterSrcs[:0]
+	}
+	iter.addEscSeq()
+	iter.grapheme.reset()
+	return line, exists
+}
 
-	var state lboState
-	state.openQuot = iter.openQuot
-	state.openApos = iter.openApos
+func (iter *LineIter) processRune(a aheadRune, brk brkType, limit int) (string, bool) {
+	if !iter.grapheme.next(a.r) {
+		iter.cluster = append(iter.cluster, a.r)
+		iter.clusterSrcs = append(iter.clusterSrcs, a.src)
+		return "", false
+	}
 
-	for r := iter.scanner.Next(); r != scanner.EOF; r = iter.scanner.Next() {
-		lineBreakOpportunity(r, &state)
+	var line string
+	var exists bool
+	if len(iter.cluster) > 0 {
+		line, exists = iter.addCluster(limit)
+	}
+	iter.cluster = append(iter.cluster[:0], a.r)
+	iter.clusterSrcs = append(iter.clusterSrcs[:0], a.src)
+	iter.clusterBrk = brk
+	return line, exists
+}
 
-		if state.lboType == lbo_break {
-			line = string(trimRight(iter.buffer.full()))
-			iter.buffer.length = 0
-			iter.width[0] = 0
-			iter.width[1] = 0
-			iter.openQuot = 0
-			iter.openApos = 0
-			iter.lboPos = 0
-			if len(line) > 0 {
-				line = iter.indent + line
+// flush is the method to process the runes which remain at the end of the
+// text, and returns a line.
+// If the returned line is the last line, this method sets isEnd true.
+func (iter *LineIter) flush(limit int) string {
+	iter.ahead.brks = iter.finder.flush(iter.ahead.brks)
+	iter.ahead.eot = true
+	if line, exists := iter.process(limit); exists {
+		return line
+	}
+
+	if len(iter.cluster) > 0 {
+		line, exists := iter.addCluster(limit)
+		iter.cluster = iter.cluster[:0]
+		iter.clusterSrcs = iter.clusterSrcs[:0]
+		if exists {
+			return line
+		}
+	}
+
+	if len(iter.escRunes) > 0 {
+		iter.escSeq.reset()
+		iter.addEscSeq()
+	}
+
+	if iter.inWord {
+		iter.inWord = false
+		if iter.fillMode == FillOptimal {
+			iter.addWordFitCands()
+		} else if line, exists := iter.hyphenateWord(limit); exists {
+			return line
+		}
+	}
+
+	if iter.fillMode == FillOptimal {
+		iter.breakParagraph()
+		if line, exists := iter.pendingLine(limit); exists {
+			return line
+		}
+	}
+
+	line := iter.lineOf(iter.buffer.length, end_paragraph)
+	iter.clearBuffer()
+
+	iter.isEnd = true
+	return line
+}
+
+// addCluster is the method to add the grapheme cluster which has been read
+// to the buffer.
+// If a line is determined by adding the cluster, this method returns the line
+// and true.
+func (iter *LineIter) addCluster(limit int) (string, bool) {
+	cluster := iter.cluster
+
+	isWord := (iter.hyphenator != nil && isWordRune(cluster[0]) &&
+		!iter.inLink())
+	if iter.inWord && !isWord && cluster[0] != softHyphen {
+		iter.inWord = false
+		if iter.fillMode == FillOptimal {
+			iter.addWordFitCands()
+		} else if line, exists := iter.hyphenateWord(limit); exists {
+			// the rest of the word and the cluster are processed for the
+			// following lines.
+			for {
+				limit = iter.lineLimit()
+				if iter.width[0]+iter.width[1] <= limit {
+					break
+				}
+				iter.pendings = append(iter.pendings, iter.cutByWidth(limit))
+			}
+			if next, exists := iter.addCluster(limit); exists {
+				iter.pendings = append(iter.pendings, next)
 			}
 			return line, true
 		}
+	}
 
-		if iter.buffer.length == 0 && state.lboType == lbo_space {
-			continue
+	if iter.clusterBrk == brk_mandatory {
+		iter.endLink()
+		iter.sep = string(cluster)
+		if iter.fillMode == FillOptimal {
+			iter.breakParagraph()
+			iter.pendings = append(iter.pendings,
+				iter.lineOf(iter.buffer.length, end_paragraph))
+			iter.clearBuffer()
+			iter.lineKind = line_paragraph
+			return iter.pendingLine(limit)
+		}
+		line := iter.lineOf(iter.buffer.length, end_paragraph)
+		iter.clearBuffer()
+		iter.lineKind = line_paragraph
+		return line, true
+	}
+
+	if len(cluster) == 1 && cluster[0] == softHyphen {
+		iter.addSoftHyphen(limit)
+		return "", false
+	}
+
+	clusterW := iter.measurer.clusterWidth(cluster)
+	if cluster[0] == tab && iter.tabMode != TabIgnore &&
+		iter.whitespace != WhitespaceCollapse {
+		col := iter.currentIndent().width +
+			iter.width[0] + iter.width[1] + iter.width[2]
+		clusterW = tabWidthAt(col, iter.tabWidth)
+	}
+	if clusterW == 0 {
+		return "", false
+	}
+
+	hasContent := (iter.width[0]+iter.width[1] > 0)
+
+	if iter.clusterBrk == brk_space {
+		iter.endLink()
+		switch {
+		case !iter.leadDone && iter.whitespace.keepsLeading():
+			iter.addToBuffer(cluster, clusterW)
+			iter.width[1] += clusterW
+		case !hasContent:
+			// spaces at the head of a line are removed.
+		case iter.whitespace != WhitespaceCollapse:
+			iter.addToBuffer(cluster, clusterW)
+			iter.width[2] += clusterW
+		case iter.width[2] == 0:
+			iter.addToBuffer([]rune{' '}, 1)
+			iter.width[2] = 1
+		}
+		return "", false
+	}
+
+	// the leading spaces of a paragraph are not followed by a lbo.
+	isLead := !iter.leadDone
+	iter.leadDone = true
+
+	if iter.linkAware {
+		iter.checkLink(cluster[0])
+	}
+
+	// escape sequences just before a lbo belong to the next line.
+	if iter.clusterBrk == brk_allowed && hasContent && !isLead {
+		lboPos := iter.buffer.length - iter.escLen
+		if iter.fillMode == FillOptimal {
+			iter.addFitCand(lboPos, false)
+			iter.cands[len(iter.cands)-1].link = iter.inLink()
+		}
+		iter.lboHyphen = (iter.lboHyphen && lboPos == iter.lboPos)
+		iter.lboPos = lboPos
+		iter.width[0] += iter.width[1] + iter.width[2]
+		iter.width[1] = 0
+		iter.width[2] = 0
+	}
+
+	if iter.linkAware {
+		iter.markLinkStart()
+	}
+
+	// the break in a word is determined at the end of the word if hyphenation
+	// is enabled.
+	if iter.fillMode == FillGreedy && hasContent && !(isWord && iter.inWord) &&
+		(iter.width[0]+iter.width[1]+iter.width[2]+clusterW) > limit {
+		if iter.hangs(cluster[0]) {
+			iter.addToBuffer(cluster, clusterW)
+			iter.width[1] += clusterW
+			iter.hangW += clusterW
+			return "", false
 		}
 
-		runeW := RuneWidth(r)
 		lboPos := iter.lboPos
+		end := end_wrap
+		if iter.lboHyphen {
+			end = end_hyphen
+		}
+		// break at a hyphenation point or forcely when no lbo in the current
+		// line.
+		if lboPos == 0 {
+			lboPos, end = iter.hyphenPos(iter.buffer.length, limit), end_hyphen
+		}
+		if lboPos == 0 {
+			lboPos, end = iter.forcedBreak(iter.buffer.length-iter.escLen,
+				iter.width[0]+iter.width[1]+iter.width[2], limit, cluster[0])
+		}
+		carried := iter.widthOf(lboPos, iter.buffer.length)
 
-		if (iter.width[0] + iter.width[1] + runeW) > limit {
-			if state.lboPrev == lbo_before {
-				line := string(trimRight(iter.buffer.runes[0:lboPos]))
-				iter.buffer.cr(lboPos)
+		line := iter.lineOf(lboPos, end)
+		iter.crBuffer(lboPos)
 
-				iter.buffer.add(r)
-				iter.width[0] = iter.width[1] + runeW
-				iter.width[1] = 0
-				iter.lboPos = iter.buffer.length
+		iter.addToBuffer(cluster, clusterW)
+		iter.width[0] = 0
+		iter.width[1] = carried + clusterW
+		iter.width[2] = 0
+		iter.lineKind = line_continuation
+		iter.startWord(isWord, clusterW)
+		iter.findLinkLbo()
+		return line, true
+	}
 
-				iter.openQuot = state.openQuot
-				iter.openApos = state.openApos
+	iter.addToBuffer(cluster, clusterW)
+	iter.width[1] += iter.width[2] + clusterW
+	iter.width[2] = 0
+	iter.hangW = 0
+	iter.startWord(isWord, clusterW)
+	return "", false
+}
 
-				if len(line) > 0 {
-					line = iter.indent + line
-				}
-				return line, true
-			}
+// hangs is the method that returns true if the specified rune, which
+// overflows the line, hangs beyond the end of the line by the kinsoku
+// strategy.
+func (iter *LineIter) hangs(r rune) bool {
+	if iter.width[2] > 0 {
+		return false
+	}
 
-			switch state.lboType {
-			case lbo_before, lbo_both, lbo_space:
-				lboPos = iter.buffer.length
-			}
-			// break forcely when no lbo in the current line.
-			if lboPos == 0 {
-				iter.width[0] += iter.width[1]
-				iter.width[1] = 0
-				lboPos = iter.buffer.length
-			}
+	switch iter.kinsoku {
+	case KinsokuBurasage:
+		return iter.hangW == 0 && strings.ContainsRune(hangingPuncts, r)
+	case KinsokuOikomi:
+		if iter.hangW > 0 {
+			return false
+		}
+		rules := defaultRules
+		if iter.hasRules {
+			rules = iter.rules
+		}
+		return rules.IsNoBreakBefore(r)
+	}
+	return false
+}
 
-			line := string(trimRight(iter.buffer.runes[0:lboPos]))
-			iter.buffer.cr(lboPos)
-
-			switch state.lboType {
-			case lbo_space:
-				iter.width[0] = 0
-				iter.width[1] = 0
-				iter.lboPos = 0
-			case lbo_before, lbo_both:
-				iter.buffer.add(r)
-				iter.width[0] = runeW
-				iter.width[1] = 0
-				iter.lboPos = 0
-			case lbo_after:
-				iter.buffer.add(r)
-				iter.width[0] = iter.width[1] + runeW
-				iter.width[1] = 0
-				iter.lboPos = iter.buffer.length
-			default:
-				iter.buffer.add(r)
-				iter.width[0] = iter.width[1] + runeW
-				iter.width[1] = 0
-				iter.lboPos = 0
-			}
+// startWord is the method to record the start of a word, which is hyphenated
+// if it overflows the line, when the cluster just added to the buffer is the
+// first letter of the word.
+func (iter *LineIter) startWord(isWord bool, clusterW int) {
+	if isWord && !iter.inWord {
+		iter.inWord = true
+		iter.wordStart = iter.buffer.length - iter.unitLen(iter.buffer.length)
+		iter.wordStartW = iter.width[0] + iter.width[1] - clusterW
+	}
+}
 
-			iter.openQuot = state.openQuot
-			iter.openApos = state.openApos
+// addSoftHyphen is the method to add a soft hyphen (U+00AD) to the buffer as
+// a zero-width unit, and to set a lbo after it if a hyphen can be put there.
+func (iter *LineIter) addSoftHyphen(limit int) {
+	if iter.width[0]+iter.width[1] == 0 {
+		return
+	}
+	iter.addToBuffer(iter.cluster, 0)
+	if iter.inLink() {
+		return
+	}
+	if iter.width[2] == 0 && iter.fillMode == FillOptimal {
+		iter.addFitCand(iter.buffer.length, true)
+	} else if iter.width[2] == 0 && iter.width[0]+iter.width[1]+1 <= limit {
+		iter.lboPos = iter.buffer.length
+		iter.lboHyphen = true
+		iter.width[0] += iter.width[1]
+		iter.width[1] = 0
+	}
+}
 
-			if len(line) > 0 {
-				line = iter.indent + line
-			}
-			return line, true
+// hyphenateWord is the method to break the line in the last word of the
+// buffer at a hyphenation point, if the line overflows.
+// If there is no hyphenation point which fits the line, the line is broken at
+// the lbo or forcely.
+func (iter *LineIter) hyphenateWord(limit int) (string, bool) {
+	if iter.width[0]+iter.width[1] <= limit {
+		return "", false
+	}
+
+	word := make([]rune, 0, iter.buffer.length-iter.wordStart)
+	index := make([]int, 0, cap(word))
+	for i := iter.wordStart; i < iter.buffer.length; i++ {
+		if iter.buffer.widths[i] > 0 {
+			word = append(word, iter.buffer.runes[i])
+			index = append(index, i)
 		}
+	}
+
+	iter.hyphens = iter.hyphens[:0]
+	for _, p := range iter.hyphenator.Hyphenate(string(word)) {
+		iter.hyphens = append(iter.hyphens, index[p])
+	}
 
-		if runeW > 0 {
-			iter.buffer.add(r)
+	pos := iter.hyphenPos(iter.buffer.length, limit)
+	if pos > iter.lboPos {
+		line := iter.lineOf(pos, end_hyphen)
+		iter.crBuffer(pos)
+		iter.width[0] = 0
+		iter.width[1] = iter.widthOf(0, iter.buffer.length)
+		iter.width[2] = 0
+		iter.lineKind = line_continuation
+		return line, true
+	}
+
+	if iter.lboPos > 0 {
+		end := end_wrap
+		if iter.lboHyphen {
+			end = end_hyphen
 		}
-		switch state.lboType {
-		case lbo_before:
-			if state.lboPrev != lbo_before {
-				iter.lboPos = iter.buffer.length - 1
-			}
-			iter.width[0] += iter.width[1]
-			iter.width[1] = runeW
-		case lbo_both:
-			iter.lboPos = iter.buffer.length - 1
-			iter.width[0] += iter.width[1]
-			iter.width[1] = runeW
-		case lbo_after, lbo_space:
-			iter.lboPos = iter.buffer.length
-			iter.width[0] += iter.width[1] + runeW
-			iter.width[1] = 0
-		default:
-			iter.width[1] += runeW
+		line := iter.lineOf(iter.lboPos, end)
+		iter.crBuffer(iter.lboPos)
+		iter.width[0] = 0
+		iter.width[1] = iter.widthOf(0, iter.buffer.length)
+		iter.width[2] = 0
+		iter.lineKind = line_continuation
+		return line, true
+	}
+
+	return iter.cutByWidth(limit), true
+}
+
+// hyphenPos is the method that returns the last hyphenation point before the
+// specified position at which a line with a hyphen fits the limit.
+// If there is no such point, this method returns 0.
+func (iter *LineIter) hyphenPos(before, limit int) int {
+	pos := 0
+	for _, h := range iter.hyphens {
+		if h <= before && iter.widthOf(0, h)+1 <= limit {
+			pos = h
+		}
+	}
+	return pos
+}
+
+// forcedBreak is the method that returns the position of a forced break and
+// the kind of the line end.
+// If a hyphen on forced breaks is enabled and the break is in a word, the
+// position is moved back so that the hyphen fits the limit.
+func (iter *LineIter) forcedBreak(pos, lineW, limit int, next rune) (int, lineEnd) {
+	iter.forced = true
+	if !iter.forcedHyphen || !isWordRune(next) || iter.inLink() {
+		return pos, end_wrap
+	}
+
+	last := pos - iter.unitLen(pos)
+	for last > 0 && iter.buffer.widths[last] <= 0 {
+		last -= iter.unitLen(last)
+	}
+	if last < 0 || !isWordRune(iter.buffer.runes[last]) {
+		return pos, end_wrap
+	}
+	if lineW+1 <= limit {
+		return pos, end_hyphen
+	}
+	if last == 0 {
+		return pos, end_wrap
+	}
+	return last, end_hyphen
+}
+
+// unitLen is the method that returns the rune count of the unit, a grapheme
+// cluster or an escape sequence, which ends at the specified position in the
+// buffer.
+func (iter *LineIter) unitLen(end int) int {
+	i := end - 1
+	for i > 0 && iter.buffer.widths[i] < 0 {
+		i--
+	}
+	return end - i
+}
+
+// widthOf is the method that returns the display width of the runes between
+// the specified positions in the buffer.
+func (iter *LineIter) widthOf(start, end int) int {
+	w := 0
+	for i := start; i < end; i++ {
+		if iter.buffer.widths[i] > 0 {
+			w += iter.buffer.widths[i]
+		}
+	}
+	return w
+}
+
+// crBuffer is the method to remove the runes before the specified position
+// from the buffer.
+func (iter *LineIter) crBuffer(pos int) {
+	iter.buffer.cr(pos)
+	iter.shiftBuffer(pos)
+}
+
+// skipBuffer is the method to remove the runes before the specified position
+// from the buffer as crBuffer, but without moving the following runes.
+// This is used to output many lines from a buffer at once, and the runes left
+// are moved by unskip of the buffer after that.
+func (iter *LineIter) skipBuffer(pos int) {
+	iter.buffer.skip(pos)
+	iter.shiftBuffer(pos)
+}
+
+// shiftBuffer is the method to update the states of the runes in the buffer
+// after the runes before the specified position are removed.
+func (iter *LineIter) shiftBuffer(pos int) {
+	iter.hangW = 0
+	iter.leadDone = true
+	iter.lboPos = 0
+	iter.lboHyphen = false
+
+	iter.link.start -= pos
+	if iter.link.start < 0 {
+		iter.link.start = 0
+	}
+	iter.link.lboPos = 0
+	iter.link.lboW = 0
+	iter.link.lboHyphen = false
+
+	iter.wordStart -= pos
+	if iter.wordStart < 0 {
+		iter.wordStart = 0
+	}
+	hyphens := iter.hyphens[:0]
+	for _, h := range iter.hyphens {
+		if h > pos {
+			hyphens = append(hyphens, h-pos)
 		}
 	}
+	iter.hyphens = hyphens
+}
 
-	line = string(trimRight(iter.buffer.full()))
+// clearBuffer is the method to remove all runes from the buffer.
+func (iter *LineIter) clearBuffer() {
 	iter.buffer.length = 0
+	iter.width[0] = 0
+	iter.width[1] = 0
+	iter.width[2] = 0
+	iter.lboPos = 0
+	iter.lboHyphen = false
+	iter.escLen = 0
+	iter.inWord = false
+	iter.hyphens = iter.hyphens[:0]
+	iter.cands = iter.cands[:0]
+	iter.hangW = 0
+	iter.leadDone = false
+	iter.endLink()
+}
 
-	if len(line) > 0 {
-		line = iter.indent + line
+// addEscSeq is the method to add the escape sequence which has been read to
+// the buffer as a zero-width unit.
+func (iter *LineIter) addEscSeq() {
+	n := len(iter.escRunes)
+	if !iter.buffer.addCluster(iter.escRunes, 0) {
+		iter.buffer.grow(n)
+		iter.buffer.addCluster(iter.escRunes, 0)
 	}
-	iter.isEnd = true
-	return line, true
+	copy(iter.buffer.srcs[iter.buffer.length-n:], iter.escSrcs)
+	iter.escLen += n
+	iter.escRunes = iter.escRunes[:0]
+	iter.escSrcs = iter.escSrcs[:0]
 }
 
-func lineBreakOpportunity(r rune, state *lboState) {
-	state.lboPrev = state.lboType
+// addToBuffer is the method to add the specified runes, which are the cluster
+// being processed or a replacement of it, to the buffer with the positions of
+// the cluster in the source text.
+func (iter *LineIter) addToBuffer(cluster []rune, clusterW int) {
+	n := len(cluster)
+	if !iter.buffer.addCluster(cluster, clusterW) {
+		iter.buffer.grow(n)
+		iter.buffer.addCluster(cluster, clusterW)
+	}
+	copy(iter.buffer.srcs[iter.buffer.length-n:iter.buffer.length],
+		iter.clusterSrcs)
+	iter.escLen = 0
+}
 
-	switch r {
-	case 0x22: // "
-		if state.openQuot == 0 { // open
-			state.openQuot = state.openApos + 1
-			state.lboType = lbo_before
-		} else { // close
-			if state.openQuot < state.openApos {
-				state.openApos = 0
+// lineOf is the method that returns a line which consists of the runes before
+// the specified position in the buffer.
+// The trailing spaces of the line are removed, except those which fit the
+// line width in WhitespacePreserve mode, but the escape sequences after them
+// are kept.
+// Soft hyphens in the line are removed, and a hyphen is put at the end of the
+// line if the line is broken in a word.
+// Tabs in the line are expanded or preserved by the tab mode.
+// If SGR attributes or a hyperlink are active, they are restored at the head
+// of the line and are reset at the end of the line.
+func (iter *LineIter) lineOf(pos int, lineEnd lineEnd) string {
+	meta := lineMeta{hard: (lineEnd == end_paragraph), forced: iter.forced}
+	meta.start, meta.end = iter.srcRange(pos)
+	iter.forced = false
+
+	runes, widths := removeSoftHyphens(iter.buffer.runes[0:pos],
+		iter.buffer.widths[0:pos])
+	if iter.tabMode != TabIgnore {
+		runes, widths = expandTabs(runes, widths, iter.currentIndent().width,
+			iter.tabWidth, iter.tabMode == TabExpand)
+	}
+	pos = len(runes)
+
+	end := 0
+	for i := 0; i < pos; i++ {
+		if widths[i] > 0 && !unicode.IsSpace(runes[i]) {
+			end = unitEnd(widths, i)
+		}
+	}
+
+	if meta.hard {
+		meta.sep = iter.sep
+		iter.sep = ""
+	}
+
+	lineWidth := iter.lineLimit()
+	if iter.whitespace == WhitespacePreserve && lineEnd != end_hyphen {
+		end = keepTrailingSpaces(runes, widths, end, pos, lineWidth)
+	}
+
+	if lineEnd == end_hyphen && end > 0 {
+		runes = append(append(append(make([]rune, 0, pos+1), runes[0:end]...),
+			'-'), runes[end:]...)
+		widths = append(append(append(make([]int, 0, pos+1), widths[0:end]...),
+			1), widths[end:]...)
+		end++
+		pos++
+	}
+
+	decor := iter.decor
+	iter.decor.isSet = false
+	iter.lineNo++
+	if iter.lineKind != line_continuation {
+		iter.paraNo++
+	}
+
+	sgrPrefix := iter.sgr.prefix()
+
+	left, content, right := alignLine(runes, widths, end, lineWidth,
+		iter.align, lineEnd == end_paragraph, len(decor.suffix) > 0)
+
+	line := make([]rune, 0, len(content)+pos-end)
+	line = append(line, content...)
+	for i := 0; i < pos; i++ {
+		if widths[i] != 0 {
+			continue
+		}
+		j := unitEnd(widths, i)
+		iter.sgr.update(string(runes[i:j]))
+		if i >= end {
+			line = append(line, runes[i:j]...)
+		}
+	}
+
+	iter.isEmpty = (end == 0)
+	meta.width = decor.width
+	if !iter.isEmpty {
+		meta.width += iter.currentIndent().width + len(left) + len(right) +
+			len(content) - end
+		for i := 0; i < end; i++ {
+			if widths[i] > 0 {
+				meta.width += widths[i]
 			}
-			state.openQuot = 0
-			state.lboType = lbo_after
 		}
-		return
-	case 0x27: // '
-		if state.openApos == 0 { // open
-			state.openApos = state.openQuot + 1
-			state.lboType = lbo_before
-		} else { // close
-			if state.openApos < state.openQuot {
-				state.openQuot = 0
+	}
+	iter.metas = append(iter.metas, meta)
+
+	if iter.isEmpty {
+		return decor.prefix + decor.suffix
+	}
+	return decor.prefix + iter.currentIndent().text + left + sgrPrefix +
+		string(line) + iter.sgr.suffix() + right + decor.suffix
+}
+
+// srcRange is the method that returns the positions in the source text of the
+// first rune of the line, which consists of the runes before the specified
+// position in the buffer, and of the position just after its last visible
+// rune.
+func (iter *LineIter) srcRange(pos int) (srcPos, srcPos) {
+	last := -1
+	for i := 0; i < pos; i++ {
+		if iter.buffer.widths[i] > 0 && !unicode.IsSpace(iter.buffer.runes[i]) {
+			last = unitEnd(iter.buffer.widths[0:pos], i) - 1
+		}
+	}
+
+	if last < 0 {
+		here := srcPos{offset: iter.srcOffset, index: iter.srcIndex}
+		if len(iter.clusterSrcs) > 0 {
+			here = iter.clusterSrcs[0]
+		} else if !iter.ahead.isEmpty() {
+			a, _ := iter.ahead.first()
+			here = a.src
+		}
+		here.size = 0
+		return here, here
+	}
+
+	src := iter.buffer.srcs[last]
+	end := srcPos{offset: src.offset + src.size, index: src.index + 1}
+	return iter.buffer.srcs[0], end
+}
+
+// removeSoftHyphens is the function that returns the runes and their widths
+// from which the soft hyphens are removed.
+func removeSoftHyphens(runes []rune, widths []int) ([]rune, []int) {
+	n := 0
+	for i, r := range runes {
+		if r == softHyphen && widths[i] == 0 {
+			n++
+		}
+	}
+	if n == 0 {
+		return runes, widths
+	}
+
+	rs := make([]rune, 0, len(runes)-n)
+	ws := make([]int, 0, len(runes)-n)
+	for i, r := range runes {
+		if r == softHyphen && widths[i] == 0 {
+			continue
+		}
+		rs = append(rs, r)
+		ws = append(ws, widths[i])
+	}
+	return rs, ws
+}
+
+// unitEnd is the function that returns the end index of the unit, a grapheme
+// cluster or an escape sequence, which starts at the specified index.
+func unitEnd(widths []int, i int) int {
+	for i++; i < len(widths); i++ {
+		if widths[i] >= 0 {
+			return i
+		}
+	}
+	return i
+}
+
+// cutByWidth is the method to break the runes in the buffer forcely by the
+// specified width.
+// This method is used when the runes carried over from the previous line are
+// wider than the current line width, for example, after the indent width is
+// increased.
+func (iter *LineIter) cutByWidth(limit int) string {
+	w := 0
+	for i := 0; i < iter.buffer.length; i++ {
+		clusterW := iter.buffer.widths[i]
+		if clusterW < 0 {
+			continue
+		}
+		if w+clusterW > limit && i > 0 {
+			pos, end := iter.hyphenPos(i, limit), end_hyphen
+			if pos == 0 {
+				pos, end = iter.forcedBreak(i, w, limit, iter.buffer.runes[i])
 			}
-			state.openApos = 0
-			state.lboType = lbo_after
+			cut := iter.widthOf(0, pos)
+			line := iter.lineOf(pos, end)
+			iter.crBuffer(pos)
+			iter.width[1] = iter.width[0] + iter.width[1] - cut
+			iter.width[0] = 0
+			iter.lineKind = line_continuation
+			return line
+		}
+		w += clusterW
+	}
+
+	line := iter.lineOf(iter.buffer.length, end_wrap)
+	iter.clearBuffer()
+	iter.leadDone = true
+	iter.lineKind = line_continuation
+	return line
+}
+
+func (state *lboState) find(r rune, brks []brkType) []brkType {
+	return append(brks, state.next(r))
+}
+
+func (state *lboState) flush(brks []brkType) []brkType {
+	return brks
+}
+
+func (state *lboState) next(r rune) brkType {
+	lineBreakOpportunity(r, state)
+
+	prev := state.prev
+	state.prev[0], state.prev[1] = r, prev[0]
+
+	switch state.lboType {
+	case lbo_break:
+		state.clearQuotes()
+		return brk_mandatory
+	case lbo_space:
+		// spaces after an opening bracket or quote are not lbo.
+		if state.lboPrev == lbo_before {
+			state.lboType = lbo_before
+		}
+		return brk_space
+	case lbo_glue:
+		// no-break spaces glue the runes before and after them, but a line can
+		// be broken after normal spaces before them.
+		if state.lboPrev == lbo_space {
+			return brk_allowed
+		}
+		return brk_never
+	case lbo_joiner:
+		return brk_never
+	}
+
+	if inNumber(r, prev[0], prev[1]) {
+		return brk_never
+	}
+
+	// a line is not broken between em dashes.
+	if contains(lboEmDashes, r) && contains(lboEmDashes, prev[0]) {
+		return brk_never
+	}
+
+	switch state.lboPrev {
+	case lbo_glue, lbo_joiner:
+		return brk_never
+	case lbo_space:
+		// a closing quote or a punctuation is not at the head of a line even
+		// after spaces, as "« Bonjour ! »" with French spacing.
+		if state.lboType == lbo_after && isSpacedClosing(r) {
+			return brk_never
 		}
+		return brk_allowed
+	case lbo_after:
+		// the runes which cannot be at the head of a line are kept together,
+		// as "。」", but an opening bracket, which cannot be at the end of a
+		// line either, starts a new phrase, as "。「".
+		if state.rules.IsNoBreakBefore(r) && !state.rules.IsNoBreakAfter(r) {
+			return brk_never
+		}
+		return brk_allowed
+	case lbo_before:
+		return brk_never
+	}
+
+	switch state.lboType {
+	case lbo_before, lbo_both:
+		return brk_allowed
+	}
+	return brk_never
+}
+
+func (state *lboState) reset() {
+	*state = lboState{rules: state.rules, quotes: state.quotes[:0]}
+}
+
+func (state *lboState) setRules(rules Rules) {
+	state.rules = rules
+}
+
+func lineBreakOpportunity(r rune, state *lboState) {
+	state.lboPrev = state.lboType
+
+	state.resolveQuote(r)
+	if state.quote(r) {
 		return
 	}
 
@@ -281,16 +1497,53 @@ func lineBreakOpportunity(r rune, state *lboState) {
 		return
 	}
 
-	if contains(lboBefores, r) {
+	switch {
+	case contains(lboGlues, r):
+		state.lboType = lbo_glue
+		return
+	case contains(lboJoiners, r):
+		state.lboType = lbo_joiner
+		return
+	case r == zeroWidthSpace:
+		// a zero width space is an invisible lbo and is removed as a space.
+		state.lboType = lbo_space
+		return
+	}
+
+	if state.rules.IsNoBreakAfter(r) {
 		state.lboType = lbo_before
 		return
 	}
 
-	if contains(lboAfters, r) {
+	if state.rules.IsNoBreakBefore(r) {
 		state.lboType = lbo_after
 		return
 	}
 
+	// a hyphen at the head of a word, such as a minus sign or the hyphens of a
+	// command line flag, is kept with the following rune.
+	if contains(lboHyphens, r) {
+		state.lboType = lbo_never
+		if isWordChar(state.prev[0]) {
+			state.lboType = lbo_after
+		}
+		return
+	}
+
+	if contains(lboEmDashes, r) {
+		switch before, after := state.rules.breaksAroundEmDash(); {
+		case before && after:
+			state.lboType = lbo_both
+		case before:
+			state.lboType = lbo_before
+		case after:
+			state.lboType = lbo_after
+		default:
+			state.lboType = lbo_never
+		}
+		return
+	}
+
 	if unicode.IsSpace(r) {
 		state.lboType = lbo_space
 		return
@@ -298,13 +1551,38 @@ func lineBreakOpportunity(r rune, state *lboState) {
 
 	switch width.LookupRune(r).Kind() {
 	case width.EastAsianWide, width.EastAsianFullwidth:
-		state.lboType = lbo_both
-		return
+		// East Asian letters are kept together with the keep-all rule.
+		if !state.rules.keepAll || !unicode.IsLetter(r) {
+			state.lboType = lbo_both
+			return
+		}
 	}
 
 	state.lboType = lbo_never
 }
 
+// inNumber is the function that returns true if the specified rune continues
+// a number with the previous runes, such as the digits after a decimal point
+// or a thousands separator, or a currency or percent sign next to a digit.
+func inNumber(r, prev, prev2 rune) bool {
+	switch {
+	case unicode.IsDigit(r):
+		return unicode.IsDigit(prev) || unicode.Is(unicode.Sc, prev) ||
+			contains(lboHyphens, prev) ||
+			(contains(numSeparators, prev) && unicode.IsDigit(prev2))
+	case unicode.IsDigit(prev):
+		return contains(numSeparators, r) || contains(numPostfixes, r) ||
+			unicode.Is(unicode.Sc, r)
+	}
+	return false
+}
+
+// isWordChar is the function that returns true if the specified rune is a
+// letter or a digit.
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
 func contains(candidates []rune, r rune) bool {
 	for _, e := range candidates {
 		if e == r {
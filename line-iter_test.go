@@ -1,9 +1,12 @@
 package linebreak_test
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/sttk/linebreak"
@@ -674,6 +677,163 @@ func TestLineIter_prohibitionsOfLineBreakOfJapanese_end(t *testing.T) {
 	assert.Equal(t, line, "")
 }
 
+func TestLineIter_kinsokuOidashi(t *testing.T) {
+	text := "置かないのだ。次の文。"
+	iter := linebreak.New(text, 12)
+	iter.SetKinsokuStrategy(linebreak.KinsokuOidashi)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "置かないの")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "だ。次の文。")
+
+	assert.False(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.False(t, exists)
+	assert.Equal(t, line, "")
+}
+
+func TestLineIter_kinsokuBurasage(t *testing.T) {
+	text := "置かないのだ。次の文。"
+	iter := linebreak.New(text, 12)
+	iter.SetKinsokuStrategy(linebreak.KinsokuBurasage)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "置かないのだ。")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "次の文。")
+
+	assert.False(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.False(t, exists)
+	assert.Equal(t, line, "")
+}
+
+func TestLineIter_kinsokuBurasage_notPunctuation(t *testing.T) {
+	text := "吾輩は猫だよ」名前。"
+	iter := linebreak.New(text, 12)
+	iter.SetKinsokuStrategy(linebreak.KinsokuBurasage)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "吾輩は猫だ")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "よ」名前。")
+
+	assert.False(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.False(t, exists)
+	assert.Equal(t, line, "")
+}
+
+func TestLineIter_kinsokuOikomi(t *testing.T) {
+	text := "吾輩は猫だよ」名前。"
+	iter := linebreak.New(text, 12)
+	iter.SetKinsokuStrategy(linebreak.KinsokuOikomi)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "吾輩は猫だよ」")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "名前。")
+
+	assert.False(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.False(t, exists)
+	assert.Equal(t, line, "")
+}
+
+func TestLineIter_kinsokuOikomi_consecutive(t *testing.T) {
+	text := "置かないのだ。」次の文。"
+	iter := linebreak.New(text, 12)
+	iter.SetKinsokuStrategy(linebreak.KinsokuOikomi)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "置かないの")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "だ。」次の文。")
+
+	assert.False(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.False(t, exists)
+	assert.Equal(t, line, "")
+}
+
+func TestLineIter_kinsoku_consecutiveNoBreakBefore(t *testing.T) {
+	lines := linebreak.WrapLines("置かないのだ。」次の文。", 14)
+	assert.Equal(t, lines, []string{"置かないの", "だ。」次の文。"})
+
+	lines = linebreak.WrapLines("これは文です。「引用」です。", 14)
+	assert.Equal(t, lines, []string{"これは文です。", "「引用」です。"})
+}
+
+func TestLineIter_kinsokuOikomi_limit(t *testing.T) {
+	lines := linebreak.WrapLines("あいうえお。。。。。。", 10,
+		linebreak.WithKinsokuStrategy(linebreak.KinsokuOikomi))
+	assert.Equal(t, lines, []string{"あいうえ", "お。。。。。", "。"})
+	for _, line := range lines {
+		assert.True(t, linebreak.TextWidth(line) <= 12)
+	}
+
+	lines = linebreak.WrapLines("あいうえおか。」", 10,
+		linebreak.WithKinsokuStrategy(linebreak.KinsokuOikomi))
+	assert.Equal(t, lines, []string{"あいうえお", "か。」"})
+}
+
+func TestLineIter_kinsoku_endOfLineWithStrategies(t *testing.T) {
+	text := "開き括弧は「行末に置く」ことは禁止である。"
+	iter := linebreak.New(text, 12)
+	iter.SetKinsokuStrategy(linebreak.KinsokuOikomi)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "開き括弧は")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "「行末に置く」")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "ことは禁止で")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "ある。")
+
+	assert.False(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.False(t, exists)
+	assert.Equal(t, line, "")
+}
+
 func TestLineIter_prohibitionsOfLineBreakOfEnglish(t *testing.T) {
 	text := "abc def ghi(jkl mn opq rst uvw xyz)"
 	iter := linebreak.New(text, 11)
@@ -791,3 +951,1179 @@ func TestLineIter_japanese(t *testing.T) {
 		fmt.Println(line)
 	}
 }
+
+func TestLineIter_SetBreakAlgorithm_uax14(t *testing.T) {
+	text := "state-of-the-art $3.14159 (approx.) 「日本語」です。"
+	iter := linebreak.New(text, 12)
+	iter.SetBreakAlgorithm(linebreak.BreakUAX14)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "state-of-")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "the-art")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "$3.14159")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "(approx.)")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "「日本語」で")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "す。")
+
+	assert.False(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.False(t, exists)
+	assert.Equal(t, line, "")
+}
+
+func TestLineIter_SetBreakAlgorithm_uax14_lookahead(t *testing.T) {
+	// "$" and "(" are not broken only if a number follows them.
+	text := "aaaa $(12) bbbb $(x)"
+	iter := linebreak.New(text, 6)
+	iter.SetBreakAlgorithm(linebreak.BreakUAX14)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"aaaa", "$(12)", "bbbb $", "(x)"})
+}
+
+func TestLineIter_SetBreakAlgorithm_uax14_crlf(t *testing.T) {
+	text := "abc\r\ndef\rghi\u2028jkl"
+	iter := linebreak.New(text, 20)
+	iter.SetBreakAlgorithm(linebreak.BreakUAX14)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"abc", "def", "ghi", "jkl"})
+}
+
+func TestLineIter_graphemeClusters(t *testing.T) {
+	text := "cafe\u0301 cafe\u0301s 🇯🇵🇯🇵🇯🇵 👨\u200d👩\u200d👧👨\u200d👩\u200d👧"
+	iter := linebreak.New(text, 5)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "cafe\u0301")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "cafe\u0301s")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "🇯🇵🇯🇵")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "🇯🇵 👨\u200d👩\u200d👧")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "👨\u200d👩\u200d👧")
+
+	assert.False(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.False(t, exists)
+	assert.Equal(t, line, "")
+}
+
+func TestLineIter_graphemeClustersAreNotSplit(t *testing.T) {
+	text := "e\u0301e\u0301e\u0301e\u0301e\u0301"
+	iter := linebreak.New(text, 2)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"e\u0301e\u0301", "e\u0301e\u0301", "e\u0301"})
+}
+
+func TestLineIter_sgrEscapeSequences(t *testing.T) {
+	text := "\x1b[31mabc def\x1b[0m ghi \x1b[1mjkl\x1b[0m"
+	iter := linebreak.New(text, 4)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "\x1b[31mabc\x1b[0m")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "\x1b[31mdef\x1b[0m")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "ghi")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "\x1b[1mjkl\x1b[0m")
+
+	assert.False(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.False(t, exists)
+	assert.Equal(t, line, "")
+}
+
+func TestLineIter_escapeSequencesAreNotSplit(t *testing.T) {
+	text := "ab\x1b[38;5;196mcdef"
+	iter := linebreak.New(text, 3)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "ab\x1b[38;5;196mc\x1b[0m")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "\x1b[38;5;196mdef\x1b[0m")
+
+	assert.False(t, iter.HasNext())
+}
+
+func TestLineIter_sgrAttributesAreClosed(t *testing.T) {
+	text := "\x1b[31mred\x1b[39m plain \x1b[1mbold\x1b[22m words"
+	iter := linebreak.New(text, 6)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"\x1b[31mred\x1b[39m",
+		"plain",
+		"\x1b[1mbold\x1b[22m",
+		"words",
+	})
+}
+
+func TestLineIter_sgrAttributesAreNormalized(t *testing.T) {
+	text := "\x1b[1m\x1b[31m\x1b[44maa \x1b[22m\x1b[1mbb \x1b[38;5;196;4mcc dd\x1b[0m"
+	iter := linebreak.New(text, 3)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"\x1b[1m\x1b[31m\x1b[44maa\x1b[0m",
+		"\x1b[1;31;44m\x1b[22m\x1b[1mbb\x1b[0m",
+		"\x1b[1;31;44m\x1b[38;5;196;4mcc\x1b[0m",
+		"\x1b[1;4;38;5;196;44mdd\x1b[0m",
+	})
+}
+
+func TestLineIter_sgrPrefixDoesNotGrow(t *testing.T) {
+	text := strings.Repeat("\x1b[1mab\x1b[22m \x1b[31m", 100) + "end"
+	iter := linebreak.New(text, 2)
+
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		assert.True(t, len(line) <= len("\x1b[31m\x1b[1mab\x1b[22m\x1b[31m\x1b[0m"))
+	}
+}
+
+func TestLineIter_hyperlinkEscapeSequences(t *testing.T) {
+	text := "see \x1b]8;;http://example.com\x1b\\the docs\x1b]8;;\x1b\\ now"
+	iter := linebreak.New(text, 8)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "see \x1b]8;;http://example.com\x1b\\the\x1b]8;;\x1b\\")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "\x1b]8;;http://example.com\x1b\\docs\x1b]8;;\x1b\\ now")
+
+	assert.False(t, iter.HasNext())
+}
+
+func TestLineIter_SetWidthMeasurer(t *testing.T) {
+	text := "αβγ δεζ ηθι"
+
+	iter := linebreak.New(text, 8)
+	iter.SetIndent("──")
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "──αβ")
+
+	iter.Init(text)
+	iter.SetWidthMeasurer(linebreak.NewWidthMeasurer(1))
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "──αβγ")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "──δεζ")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "──ηθι")
+
+	assert.False(t, iter.HasNext())
+}
+
+func TestNewReader(t *testing.T) {
+	r := iotest.OneByteReader(strings.NewReader("あいう えお\nかき"))
+	iter := linebreak.NewReader(r, 6)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "あいう")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "えお")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "かき")
+
+	assert.False(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.False(t, exists)
+	assert.Equal(t, line, "")
+
+	assert.Nil(t, iter.Err())
+}
+
+func TestNewReader_readError(t *testing.T) {
+	e := errors.New("read error")
+	r := io.MultiReader(strings.NewReader("abc def"), iotest.ErrReader(e))
+	iter := linebreak.NewReader(r, 4)
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "abc")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "def")
+
+	assert.False(t, iter.HasNext())
+	assert.Equal(t, iter.Err(), e)
+
+	iter.Init("ghi")
+	assert.Nil(t, iter.Err())
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "ghi")
+}
+
+func TestLineIter_InitReader(t *testing.T) {
+	iter := linebreak.New("abc", 4)
+	iter.InitReader(strings.NewReader("def ghi"))
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "def")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "ghi")
+
+	assert.False(t, iter.HasNext())
+}
+
+func TestLineIter_SetFirstIndent(t *testing.T) {
+	text := "abc def ghi\njkl mno"
+	iter := linebreak.New(text, 8)
+	iter.SetIndent("  ")
+	iter.SetFirstIndent("> ")
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "> abc")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "  def")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "  ghi")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "  jkl")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "  mno")
+
+	assert.False(t, iter.HasNext())
+
+	iter.Init("pqr")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "> pqr")
+}
+
+func TestLineIter_SetContinuationIndent_andSetParagraphIndent(t *testing.T) {
+	text := "abcdefghij klm\n\nnop qrs"
+	iter := linebreak.New(text, 6)
+	iter.SetContinuationIndent("...")
+	iter.SetParagraphIndent("#")
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "abcdef")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "...ghi")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "...j")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "...klm")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "#nop")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "...qrs")
+
+	assert.False(t, iter.HasNext())
+}
+
+func TestLineIter_SetPrefixFunc(t *testing.T) {
+	text := "abc def ghi\n\njkl"
+	iter := linebreak.New(text, 10)
+	iter.SetIndent(" ")
+	iter.SetPrefixFunc(func(info linebreak.LineInfo) string {
+		if info.IsContinuation {
+			return fmt.Sprintf("%d.%d+", info.LineNumber, info.ParagraphNumber)
+		}
+		return fmt.Sprintf("%d.%d:", info.LineNumber, info.ParagraphNumber)
+	})
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "1.1: abc")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "2.1+ def")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "3.1+ ghi")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "4.2:")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "5.3: jkl")
+
+	assert.False(t, iter.HasNext())
+
+	iter.Init("mno")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "1.1: mno")
+}
+
+func TestLineIter_SetSuffixFunc(t *testing.T) {
+	text := "abc def ghi"
+	iter := linebreak.New(text, 9)
+	iter.SetPrefixFunc(func(linebreak.LineInfo) string {
+		return "| "
+	})
+	iter.SetSuffixFunc(func(linebreak.LineInfo) string {
+		return " |"
+	})
+
+	assert.True(t, iter.HasNext())
+	line, exists := iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "| abc |")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "| def |")
+
+	assert.True(t, iter.HasNext())
+	line, exists = iter.Next()
+	assert.True(t, exists)
+	assert.Equal(t, line, "| ghi |")
+
+	assert.False(t, iter.HasNext())
+}
+
+func TestLineIter_SetAlignment(t *testing.T) {
+	text := "abc de fghij\nklm"
+
+	iter := linebreak.New(text, 8)
+	iter.SetAlignment(linebreak.AlignRight)
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"  abc de", "   fghij", "     klm"})
+
+	iter.Init(text)
+	iter.SetAlignment(linebreak.AlignCenter)
+	lines = []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{" abc de", " fghij", "  klm"})
+
+	iter.Init(text)
+	iter.SetAlignment(linebreak.AlignJustify)
+	lines = []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"abc   de", "fghij", "klm"})
+}
+
+func TestLineIter_SetAlignment_justify(t *testing.T) {
+	text := "a bb ccc dddd e ff ggg\nhhh"
+	iter := linebreak.New(text, 12)
+	iter.SetAlignment(linebreak.AlignJustify)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"a   bb   ccc",
+		"dddd   e  ff",
+		"ggg",
+		"hhh",
+	})
+}
+
+func TestLineIter_SetAlignment_justifyEastAsian(t *testing.T) {
+	text := "日本語の文章です。"
+	iter := linebreak.New(text, 13)
+	iter.SetAlignment(linebreak.AlignJustify)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"日 本語の文章", "です。"})
+}
+
+func TestLineIter_SetAlignment_withSuffix(t *testing.T) {
+	text := "abc de fghij"
+	iter := linebreak.New(text, 11)
+	iter.SetAlignment(linebreak.AlignCenter)
+	iter.SetPrefixFunc(func(linebreak.LineInfo) string { return "| " })
+	iter.SetSuffixFunc(func(linebreak.LineInfo) string { return " |" })
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"| abc de  |", "|  fghij  |"})
+}
+
+func TestLineIter_SetHyphenator(t *testing.T) {
+	text := "abc hyphenation information, done"
+	iter := linebreak.New(text, 9)
+	iter.SetHyphenator(linebreak.EnglishHyphenator())
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"abc hy-",
+		"phenation",
+		"informa-",
+		"tion,",
+		"done",
+	})
+}
+
+func TestLineIter_SetHyphenator_wordBeforeMandatoryBreak(t *testing.T) {
+	text := "internationalization\nabc"
+	iter := linebreak.New(text, 8)
+	iter.SetHyphenator(linebreak.EnglishHyphenator())
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"interna-",
+		"tional-",
+		"ization",
+		"abc",
+	})
+}
+
+func TestLineIter_softHyphen(t *testing.T) {
+	text := "super­cali­fragilistic expi­ali­docious"
+	iter := linebreak.New(text, 12)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"supercali-",
+		"fragilistic",
+		"expiali-",
+		"docious",
+	})
+}
+
+func TestLineIter_SetForcedBreakHyphen(t *testing.T) {
+	text := "abcdefghijklmn 日本語です"
+	iter := linebreak.New(text, 5)
+	iter.SetForcedBreakHyphen(true)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"abcd-",
+		"efgh-",
+		"ijkl-",
+		"mn 日",
+		"本語",
+		"です",
+	})
+}
+
+func TestLineIter_SetFillMode(t *testing.T) {
+	text := "aaa bb cc ddddd"
+
+	iter := linebreak.New(text, 6)
+	iter.SetPenalties(linebreak.Penalties{})
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"aaa bb", "cc", "ddddd"})
+
+	iter = linebreak.New(text, 6)
+	iter.SetFillMode(linebreak.FillOptimal)
+	iter.SetPenalties(linebreak.Penalties{})
+	lines = []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"aaa", "bb cc", "ddddd"})
+}
+
+func TestLineIter_SetFillMode_paragraphs(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog.\n" +
+		"A second paragraph is here."
+	iter := linebreak.New(text, 16)
+	iter.SetFillMode(linebreak.FillOptimal)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"The quick brown",
+		"fox jumps over",
+		"the lazy dog.",
+		"A second",
+		"paragraph",
+		"is here.",
+	})
+}
+
+func TestLineIter_SetPenalties_widow(t *testing.T) {
+	text := "aaa bb cc dd"
+
+	iter := linebreak.New(text, 9)
+	iter.SetFillMode(linebreak.FillOptimal)
+	iter.SetPenalties(linebreak.Penalties{})
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"aaa bb cc", "dd"})
+
+	iter = linebreak.New(text, 9)
+	iter.SetFillMode(linebreak.FillOptimal)
+	iter.SetPenalties(linebreak.Penalties{Widow: 100})
+	lines = []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"aaa bb", "cc dd"})
+}
+
+func TestDefaultPenalties(t *testing.T) {
+	assert.Equal(t, linebreak.DefaultPenalties(), linebreak.Penalties{
+		Hyphen: 50, Widow: 100, Orphan: 100,
+	})
+
+	p := linebreak.DefaultPenalties()
+	p.Widow = 0
+	assert.Equal(t, linebreak.DefaultPenalties().Widow, 100)
+
+	text := "aaa bb cc dd"
+	iter := linebreak.New(text, 9)
+	iter.SetFillMode(linebreak.FillOptimal)
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"aaa bb", "cc dd"})
+}
+
+func TestLineIter_SetPenalties_hyphen(t *testing.T) {
+	text := "hyphenation processing tables"
+
+	iter := linebreak.New(text, 12)
+	iter.SetHyphenator(linebreak.EnglishHyphenator())
+	iter.SetFillMode(linebreak.FillOptimal)
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"hyphenation", "process-", "ing tables"})
+
+	iter = linebreak.New(text, 12)
+	iter.SetHyphenator(linebreak.EnglishHyphenator())
+	iter.SetFillMode(linebreak.FillOptimal)
+	iter.SetPenalties(linebreak.Penalties{Hyphen: 10000})
+	lines = []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"hyphenation", "processing", "tables"})
+}
+
+func TestLineIter_SetTabMode_expand(t *testing.T) {
+	text := "name\tvalue\tdescription of it\nab\tc\td"
+	iter := linebreak.New(text, 20)
+	iter.SetTabMode(linebreak.TabExpand)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"name    value",
+		"description of it",
+		"ab      c       d",
+	})
+}
+
+func TestLineIter_SetTabMode_preserve(t *testing.T) {
+	text := "name\tvalue\tdescription of it\nab\tc\td"
+	iter := linebreak.New(text, 20)
+	iter.SetTabMode(linebreak.TabPreserve)
+	iter.SetIndent("  ")
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"  name\tvalue",
+		"  description of it",
+		"  ab\tc\td",
+	})
+}
+
+func TestLineIter_SetTabMode_fillOptimal(t *testing.T) {
+	text := "a\tb c\td e f g"
+
+	for _, lineWidth := range []int{9, 10} {
+		lines := linebreak.WrapLines(text, lineWidth,
+			linebreak.WithFillMode(linebreak.FillOptimal),
+			linebreak.WithTabMode(linebreak.TabExpand))
+		assert.Equal(t, lines, []string{"a       b", "c       d", "e f g"})
+		for _, line := range lines {
+			assert.True(t, linebreak.TextWidth(line) <= lineWidth)
+		}
+	}
+
+	lines := linebreak.WrapLines(text, 10,
+		linebreak.WithFillMode(linebreak.FillOptimal),
+		linebreak.WithTabMode(linebreak.TabExpand),
+		linebreak.WithContinuationIndent("  "))
+	assert.Equal(t, lines, []string{"a       b", "  c     d", "  e f g"})
+}
+
+func TestLineIter_SetTabWidth(t *testing.T) {
+	text := "name\tvalue\tdescription of it\nab\tc\td"
+	iter := linebreak.New(text, 20)
+	iter.SetTabMode(linebreak.TabExpand)
+	iter.SetTabWidth(4)
+	iter.SetIndent("  ")
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"  name  value",
+		"  description of it",
+		"  ab    c   d",
+	})
+}
+
+func TestLineIter_SetWhitespaceMode(t *testing.T) {
+	text := "Usage:\n    go   build   [flags]   packages   more\n  |  |  "
+
+	iter := linebreak.New(text, 20)
+	iter.SetWhitespaceMode(linebreak.WhitespaceCollapse)
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"Usage:",
+		"go build [flags]",
+		"packages more",
+		"| |",
+	})
+
+	iter = linebreak.New(text, 20)
+	iter.SetWhitespaceMode(linebreak.WhitespacePreserve)
+	lines = []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"Usage:",
+		"    go   build   ",
+		"[flags]   packages  ",
+		"more",
+		"  |  |  ",
+	})
+
+	iter = linebreak.New(text, 20)
+	iter.SetWhitespaceMode(linebreak.WhitespacePreserveLeading)
+	lines = []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"Usage:",
+		"    go   build",
+		"[flags]   packages",
+		"more",
+		"  |  |",
+	})
+}
+
+func TestLineIter_SetWhitespaceMode_withTab(t *testing.T) {
+	text := "all:\n\tgo build main.go\n\tgo test -v"
+	iter := linebreak.New(text, 16)
+	iter.SetWhitespaceMode(linebreak.WhitespacePreserveLeading)
+	iter.SetTabMode(linebreak.TabExpand)
+	iter.SetTabWidth(4)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"all:",
+		"    go build",
+		"main.go",
+		"    go test -v",
+	})
+}
+
+func TestLineIter_lineSeparators(t *testing.T) {
+	text := "a\r\nb\rc\nd\u0085e\vf\fg\u2028h\u2029i j k"
+
+	for _, alg := range []linebreak.BreakAlgorithm{
+		linebreak.BreakBasic, linebreak.BreakUAX14,
+	} {
+		iter := linebreak.New(text, 3)
+		iter.SetBreakAlgorithm(alg)
+
+		lines := []string{}
+		seps := []string{}
+		for iter.HasNext() {
+			line, _ := iter.Next()
+			lines = append(lines, line)
+			seps = append(seps, iter.Separator())
+		}
+		assert.Equal(t, lines, []string{
+			"a", "b", "c", "d", "e", "f", "g", "h", "i j", "k",
+		})
+		assert.Equal(t, seps, []string{
+			"\r\n", "\r", "\n", "\u0085", "\v", "\f", "\u2028", "\u2029", "", "",
+		})
+	}
+}
+
+func TestLineIter_NextLine(t *testing.T) {
+	text := "héllo wörld\r\n\nabcdefghij 日本語です"
+	iter := linebreak.New(text, 6)
+
+	lines := []linebreak.Line{}
+	for {
+		line, exists := iter.NextLine()
+		if !exists {
+			break
+		}
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []linebreak.Line{
+		{Text: "héllo", Width: 6, Start: 0, End: 6, RuneStart: 0, RuneEnd: 5},
+		{Text: "wörld", Width: 5, Start: 7, End: 13, RuneStart: 6, RuneEnd: 11,
+			HardBreak: true, Separator: "\r\n"},
+		{Text: "", Width: 0, Start: 15, End: 15, RuneStart: 13, RuneEnd: 13,
+			HardBreak: true, Separator: "\n"},
+		{Text: "abcdef", Width: 6, Start: 16, End: 22, RuneStart: 14, RuneEnd: 20,
+			Forced: true},
+		{Text: "ghij", Width: 4, Start: 22, End: 26, RuneStart: 20, RuneEnd: 24},
+		{Text: "日本語", Width: 6, Start: 27, End: 36, RuneStart: 25, RuneEnd: 28},
+		{Text: "です", Width: 4, Start: 36, End: 42, RuneStart: 28, RuneEnd: 30,
+			HardBreak: true},
+	})
+
+	for _, line := range lines[0:2] {
+		assert.Equal(t, text[line.Start:line.End], line.Text)
+	}
+}
+
+func TestLineIter_NextLine_withDecorations(t *testing.T) {
+	text := "abc \x1b[1mdef\x1b[0m ghi"
+	iter := linebreak.New(text, 9)
+	iter.SetIndent("  ")
+	iter.SetPrefixFunc(func(linebreak.LineInfo) string { return "| " })
+
+	line, exists := iter.NextLine()
+	assert.True(t, exists)
+	assert.Equal(t, line.Text, "|   abc")
+	assert.Equal(t, line.Width, 7)
+	assert.Equal(t, text[line.Start:line.End], "abc")
+
+	line, exists = iter.NextLine()
+	assert.True(t, exists)
+	assert.Equal(t, line.Text, "|   \x1b[1mdef\x1b[0m")
+	assert.Equal(t, line.Width, 7)
+	assert.Equal(t, text[line.Start:line.End], "\x1b[1mdef")
+
+	line, exists = iter.NextLine()
+	assert.True(t, exists)
+	assert.Equal(t, line.Text, "|   ghi")
+	assert.Equal(t, text[line.Start:line.End], "ghi")
+	assert.True(t, line.HardBreak)
+
+	_, exists = iter.NextLine()
+	assert.False(t, exists)
+}
+
+func TestNew_withOptions(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog."
+	iter := linebreak.New(text, 16,
+		linebreak.WithFirstIndent("* "),
+		linebreak.WithContinuationIndent("  "),
+		linebreak.WithWhitespaceMode(linebreak.WhitespaceCollapse),
+	)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"* The quick",
+		"  brown fox",
+		"  jumps over the",
+		"  lazy dog.",
+	})
+}
+
+func TestLineIter_noBreakSpaces(t *testing.T) {
+	text := "use Go\u00a0Language, 100\u202fkm and \u20071\u20072"
+
+	for _, alg := range []linebreak.BreakAlgorithm{
+		linebreak.BreakBasic, linebreak.BreakUAX14,
+	} {
+		lines := linebreak.WrapLines(text, 12, linebreak.WithBreakAlgorithm(alg))
+		assert.Equal(t, lines, []string{
+			"use",
+			"Go\u00a0Language,",
+			"100\u202fkm and",
+			"\u20071\u20072",
+		})
+	}
+}
+
+func TestLineIter_zeroWidthSpace(t *testing.T) {
+	text := "Linebreak\u200bIterator\u200bWriter"
+
+	for _, alg := range []linebreak.BreakAlgorithm{
+		linebreak.BreakBasic, linebreak.BreakUAX14,
+	} {
+		lines := linebreak.WrapLines(text, 18, linebreak.WithBreakAlgorithm(alg))
+		assert.Equal(t, lines, []string{"LinebreakIterator", "Writer"})
+	}
+}
+
+func TestLineIter_wordJoiner(t *testing.T) {
+	for _, alg := range []linebreak.BreakAlgorithm{
+		linebreak.BreakBasic, linebreak.BreakUAX14,
+	} {
+		lines := linebreak.WrapLines("日本語\u2060の文章です", 6,
+			linebreak.WithBreakAlgorithm(alg))
+		assert.Equal(t, lines, []string{"日本", "語の文", "章です"})
+
+		lines = linebreak.WrapLines("abc def-\ufeffghi", 8,
+			linebreak.WithBreakAlgorithm(alg))
+		assert.Equal(t, lines, []string{"abc", "def-ghi"})
+	}
+}
+
+func TestLineIter_numbers(t *testing.T) {
+	text := "Total: $1,234.50 (12.5% off) due 2024/10/17 12:30."
+
+	for _, alg := range []linebreak.BreakAlgorithm{
+		linebreak.BreakBasic, linebreak.BreakUAX14,
+	} {
+		lines := linebreak.WrapLines(text, 16, linebreak.WithBreakAlgorithm(alg))
+		assert.Equal(t, lines, []string{
+			"Total: $1,234.50",
+			"(12.5% off) due",
+			"2024/10/17",
+			"12:30.",
+		})
+	}
+}
+
+func TestLineIter_numbers_separatorsNotBetweenDigits(t *testing.T) {
+	lines := linebreak.WrapLines("see 1, 2 and a,b", 7)
+	assert.Equal(t, lines, []string{"see 1,", "2 and", "a,b"})
+}
+
+func TestLineIter_numbers_fullwidth(t *testing.T) {
+	lines := linebreak.WrapLines("合計は￥１，０００の１２．５％です", 12)
+	assert.Equal(t, lines, []string{"合計は", "￥１，０００", "の１２．５％", "です"})
+}
+
+func TestLineIter_hyphens(t *testing.T) {
+	text := "set state-of-the-art-configuration-key now"
+
+	for _, alg := range []linebreak.BreakAlgorithm{
+		linebreak.BreakBasic, linebreak.BreakUAX14,
+	} {
+		lines := linebreak.WrapLines(text, 12, linebreak.WithBreakAlgorithm(alg))
+		assert.Equal(t, lines, []string{
+			"set state-",
+			"of-the-art-",
+			"configuratio",
+			"n-key now",
+		})
+	}
+}
+
+func TestLineIter_hyphens_minusAndFlags(t *testing.T) {
+	text := "run with --verbose, -5 and x-5"
+
+	for _, alg := range []linebreak.BreakAlgorithm{
+		linebreak.BreakBasic, linebreak.BreakUAX14,
+	} {
+		lines := linebreak.WrapLines(text, 10, linebreak.WithBreakAlgorithm(alg))
+		assert.Equal(t, lines, []string{
+			"run with",
+			"--verbose,",
+			"-5 and x-5",
+		})
+	}
+}
+
+func TestLineIter_apostrophes(t *testing.T) {
+	text := "It's a 'quoted' word, isn't it 'ok'"
+
+	lines := linebreak.WrapLines(text, 8)
+	assert.Equal(t, lines, []string{
+		"It's a",
+		"'quoted'",
+		"word,",
+		"isn't it",
+		"'ok'",
+	})
+}
+
+func TestLineIter_curlyQuotes(t *testing.T) {
+	text := "She said ‘I don’t know’ and left the dogs’ bowls"
+
+	lines := linebreak.WrapLines(text, 10)
+	assert.Equal(t, lines, []string{
+		"She said",
+		"‘I don’t",
+		"know’ and",
+		"left the",
+		"dogs’",
+		"bowls",
+	})
+
+	text = "“Quoted text here” and “more quoted text”"
+
+	lines = linebreak.WrapLines(text, 14)
+	assert.Equal(t, lines, []string{
+		"“Quoted text",
+		"here” and",
+		"“more quoted",
+		"text”",
+	})
+}
+
+func TestLineIter_localeQuotes(t *testing.T) {
+	lines := linebreak.WrapLines("Er sagte „Hallo Welt“ und ging weiter fort", 14)
+	assert.Equal(t, lines, []string{
+		"Er sagte",
+		"„Hallo Welt“",
+		"und ging",
+		"weiter fort",
+	})
+
+	lines = linebreak.WrapLines("Il a dit « Bonjour tout le monde ! » puis", 14)
+	assert.Equal(t, lines, []string{
+		"Il a dit",
+		"« Bonjour tout",
+		"le monde ! »",
+		"puis",
+	})
+}
+
+func TestLineIter_quotesAcrossLines(t *testing.T) {
+	lines := linebreak.WrapLines("say « abc aaaa » bbbb", 6)
+	assert.Equal(t, lines, []string{"say", "« abc", "aaaa »", "bbbb"})
+}
+
+func TestLineIter_quotesAfterInit(t *testing.T) {
+	iter := linebreak.New("say « abc", 6)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"say", "« abc"})
+
+	// the quote opened in the previous text is not closed by "»", which
+	// opens a quote as German.
+	iter.Init("aaaa » bbbb")
+
+	lines = []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"aaaa", "» bbbb"})
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"strings"
+
+	"golang.org/x/text/width"
+)
+
+// linkKind is the enum type for the kind of the token being read in the link
+// aware mode.
+type linkKind int
+
+const (
+	link_none linkKind = iota // not a link, or not detected yet
+	link_url                  // a URL or a file path
+	link_mail                 // an e-mail address
+)
+
+// linkState is the struct that holds the token being read in the link aware
+// mode, and the line break opportunity just before the token.
+type linkState struct {
+	last      rune /* the first rune of the last cluster in the token */
+	count     int  /* number of the clusters in the token */
+	kind      linkKind
+	tokenKind linkKind /* kind of the whole token, read ahead at its start */
+	start     int      /* position of the token in the buffer */
+	lboPos    int      /* lbo before the token, or 0 if not in the current line */
+	lboW      int      /* width of the runes before lboPos */
+	lboHyphen bool     /* whether a hyphen is put when broken at lboPos */
+}
+
+// The prefixes of tokens which are regarded as URLs.
+var linkPrefixes = []string{"www.", "mailto:", "./", "../", "~/"}
+
+// The runes which can precede a link in the same token.
+const linkOpeners = "([{<\"'"
+
+// The maximum number of runes which are read ahead to find the end of a token.
+// A token longer than this is classified by its first runes of this number.
+const maxTokenLookahead = 2048
+
+// linkKindOf is the function that returns the kind of the token which consists
+// of the specified runes.
+func linkKindOf(runes []rune) linkKind {
+	s := strings.TrimLeft(string(runes), linkOpeners)
+
+	if strings.Contains(s, "://") || strings.ContainsAny(s, "/\\") {
+		return link_url
+	}
+	for _, prefix := range linkPrefixes {
+		if strings.HasPrefix(s, prefix) {
+			return link_url
+		}
+	}
+	if strings.IndexRune(s, '@') > 0 {
+		return link_mail
+	}
+	return link_none
+}
+
+// isLinkBreak is the function that returns true if a link can be broken
+// between the specified runes, which is after a slash or before a query, a
+// fragment or a parameter separator.
+func isLinkBreak(prev, r rune) bool {
+	switch r {
+	case '?', '#', '&':
+		return true
+	case '/', '\\':
+		return false
+	}
+	return prev == '/' || prev == '\\'
+}
+
+// isWideRune is the function that returns true if the specified rune is an
+// East Asian wide or fullwidth rune.
+func isWideRune(r rune) bool {
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return true
+	}
+	return false
+}
+
+// isTokenEnd is the function that returns true if the rune of which the line
+// break opportunity is the specified brk is not in the token before it.
+// An East Asian wide rune is also regarded as the end of a token, since a
+// link in East Asian text is separated from the text by the width of runes.
+func isTokenEnd(r rune, brk brkType) bool {
+	return brk == brk_space || brk == brk_mandatory || isWideRune(r)
+}
+
+// tokenKind is the method that returns the kind of the token which starts
+// with the specified rune, with the runes following it in ahead up to the end
+// of the token.
+// The head of ahead is the rune being processed after the cluster of the
+// specified rune.
+func (iter *LineIter) tokenKind(r rune) linkKind {
+	if isWideRune(r) {
+		return link_none
+	}
+	return linkKindOf(iter.ahead.token([]rune{r}))
+}
+
+// inLink is the method that returns true if the token being read is detected
+// as a link in the link aware mode.
+func (iter *LineIter) inLink() bool {
+	return iter.linkAware && iter.link.kind != link_none
+}
+
+// checkLink is the method to add the specified rune, which is the first rune
+// of the cluster being added, to the token, and to replace the line break
+// opportunity before the cluster if the token is a link.
+// The kind of the token is determined with the whole token read ahead at its
+// first rune, and when the token is a link, the line break opportunities in
+// the token are withdrawn so that the link is moved to the next line as a
+// whole.
+// Only if the link starts at the head of a line, it is broken after slashes or
+// before '?', '#' and '&'. An e-mail address has no line break opportunity,
+// and is broken forcely only if it is wider than a line.
+func (iter *LineIter) checkLink(r rune) {
+	link := &iter.link
+	prev := link.last
+
+	// a link in East Asian text is separated from the text by the width of
+	// the runes.
+	if link.count > 0 && isWideRune(prev) != isWideRune(r) {
+		link.count = 0
+	}
+
+	link.last = r
+	link.count++
+	if link.count == 1 {
+		link.kind = link_none
+		link.tokenKind = iter.tokenKind(r)
+		return
+	}
+
+	if link.kind == link_none {
+		link.kind = link.tokenKind
+		if link.kind == link_none {
+			return
+		}
+		iter.withdrawLinkLbos()
+	}
+
+	// in FillOptimal mode, the breaks in a link are candidates with a large
+	// cost.
+	iter.clusterBrk = brk_never
+	if link.kind == link_url &&
+		(link.lboPos == 0 || iter.fillMode == FillOptimal) &&
+		isLinkBreak(prev, r) {
+		iter.clusterBrk = brk_allowed
+	}
+}
+
+// markLinkStart is the method to record the line break opportunity before the
+// token if the cluster which has been added is the first of the token.
+func (iter *LineIter) markLinkStart() {
+	link := &iter.link
+	if link.count != 1 {
+		return
+	}
+	link.start = iter.buffer.length - iter.escLen
+	link.lboPos = iter.lboPos
+	link.lboW = iter.width[0]
+	link.lboHyphen = iter.lboHyphen
+}
+
+// findLinkLbo is the method to set the line break opportunity to the last
+// point in the buffer at which the link being read can be broken, after the
+// line before the link is determined.
+func (iter *LineIter) findLinkLbo() {
+	if !iter.linkAware || iter.link.kind != link_url {
+		return
+	}
+
+	var prev rune
+	for i := 0; i < iter.buffer.length; i++ {
+		if iter.buffer.widths[i] <= 0 {
+			continue
+		}
+		r := iter.buffer.runes[i]
+		if i > 0 && isLinkBreak(prev, r) {
+			iter.lboPos = i
+		}
+		prev = r
+	}
+
+	if iter.lboPos > 0 {
+		w := iter.width[0] + iter.width[1]
+		iter.width[0] = iter.widthOf(0, iter.lboPos)
+		iter.width[1] = w - iter.width[0]
+	}
+}
+
+// withdrawLinkLbos is the method to move the line break opportunity back to
+// the one before the token, and to remove the candidates of line breaks and
+// the hyphenation points in the token.
+func (iter *LineIter) withdrawLinkLbos() {
+	link := &iter.link
+
+	w := iter.width[0] + iter.width[1]
+	iter.lboPos = link.lboPos
+	iter.lboHyphen = link.lboHyphen
+	iter.width[0] = link.lboW
+	iter.width[1] = w - link.lboW
+
+	n := len(iter.cands)
+	for n > 0 && iter.cands[n-1].pos > link.start {
+		n--
+	}
+	iter.cands = iter.cands[:n]
+
+	iter.inWord = false
+	iter.hyphens = iter.hyphens[:0]
+}
+
+// endLink is the method to end the token being read.
+func (iter *LineIter) endLink() {
+	iter.link.count = 0
+	iter.link.kind = link_none
+}
@@ -0,0 +1,158 @@
+package linebreak_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sttk/linebreak"
+)
+
+func TestLineIter_SetLinkAware_url(t *testing.T) {
+	text := "Error: see https://example.com/docs/errors.html?code=42&lang=en#details for more."
+
+	iter := linebreak.New(text, 30)
+	iter.SetLinkAware(true)
+
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"Error: see",
+		"https://example.com/docs/",
+		"errors.html?code=42&lang=en",
+		"#details for more.",
+	})
+}
+
+func TestLineIter_SetLinkAware_urlWhichFits(t *testing.T) {
+	text := "Visit www.example.com/path now"
+
+	lines := linebreak.WrapLines(text, 22, linebreak.WithLinkAware(true))
+	assert.Equal(t, lines, []string{"Visit", "www.example.com/path", "now"})
+
+	lines = linebreak.WrapLines(text, 22)
+	assert.Equal(t, lines, []string{"Visit www.example.com/", "path now"})
+}
+
+func TestLineIter_SetLinkAware_path(t *testing.T) {
+	text := "failed to open /usr/local/share/linebreak/config.yaml: no such file"
+
+	lines := linebreak.WrapLines(text, 20, linebreak.WithLinkAware(true))
+	assert.Equal(t, lines, []string{
+		"failed to open",
+		"/usr/local/share/",
+		"linebreak/",
+		"config.yaml: no such",
+		"file",
+	})
+}
+
+func TestLineIter_SetLinkAware_mail(t *testing.T) {
+	text := "Contact first.last@example.com for support."
+
+	lines := linebreak.WrapLines(text, 20, linebreak.WithLinkAware(true))
+	assert.Equal(t, lines, []string{
+		"Contact",
+		"first.last@example.c",
+		"om for support.",
+	})
+
+	lines = linebreak.WrapLines(text, 24, linebreak.WithLinkAware(true))
+	assert.Equal(t, lines, []string{
+		"Contact",
+		"first.last@example.com",
+		"for support.",
+	})
+}
+
+func TestLineIter_SetLinkAware_detectedAfterOverflow(t *testing.T) {
+	text := "aaaaaaaaa mail john.doe@example.com now"
+
+	lines := linebreak.WrapLines(text, 22, linebreak.WithLinkAware(true))
+	assert.Equal(t, lines, []string{
+		"aaaaaaaaa mail",
+		"john.doe@example.com",
+		"now",
+	})
+
+	lines = linebreak.WrapLines(text, 22,
+		linebreak.WithLinkAware(true),
+		linebreak.WithBreakAlgorithm(linebreak.BreakUAX14))
+	assert.Equal(t, lines, []string{
+		"aaaaaaaaa mail",
+		"john.doe@example.com",
+		"now",
+	})
+
+	text = "see example.com/path/to/x"
+
+	lines = linebreak.WrapLines(text, 20, linebreak.WithLinkAware(true))
+	assert.Equal(t, lines, []string{"see", "example.com/path/to/", "x"})
+
+	lines = linebreak.WrapLines(text, 12, linebreak.WithLinkAware(true))
+	assert.Equal(t, lines, []string{"see", "example.com/", "path/to/x"})
+}
+
+func TestLineIter_SetLinkAware_noHyphen(t *testing.T) {
+	text := "see https://example.com/averyveryverylongpath"
+
+	lines := linebreak.WrapLines(text, 20,
+		linebreak.WithLinkAware(true),
+		linebreak.WithForcedBreakHyphen(true))
+	assert.Equal(t, lines, []string{
+		"see",
+		"https://example.com/",
+		"averyveryverylongpat",
+		"h",
+	})
+}
+
+func TestLineIter_SetLinkAware_inJapaneseText(t *testing.T) {
+	text := "詳細はhttps://example.com/a/bを参照。"
+
+	lines := linebreak.WrapLines(text, 30, linebreak.WithLinkAware(true))
+	assert.Equal(t, lines, []string{
+		"詳細はhttps://example.com/a/b",
+		"を参照。",
+	})
+}
+
+func TestWriter_SetLinkAware(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 22)
+	w.SetLinkAware(true)
+	_, err := w.Write([]byte("Visit www.example.com/path now"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, buf.String(), "Visit\nwww.example.com/path\nnow\n")
+}
+
+func TestWriter_SetLinkAware_linkInWrites(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 22)
+	w.SetLinkAware(true)
+	_, err := w.Write([]byte("aaaaaaaaa mail john."))
+	assert.Nil(t, err)
+	_, err = w.Write([]byte("doe@example.com now"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, buf.String(),
+		"aaaaaaaaa mail\njohn.doe@example.com\nnow\n")
+}
+
+func TestLineIter_SetLinkAware_fillOptimal(t *testing.T) {
+	text := "aaa bbb www.example.com/a cc dd"
+
+	lines := linebreak.WrapLines(text, 18,
+		linebreak.WithLinkAware(true),
+		linebreak.WithFillMode(linebreak.FillOptimal))
+	assert.Equal(t, lines, []string{"aaa bbb", "www.example.com/a", "cc dd"})
+
+	lines = linebreak.WrapLines(text, 16,
+		linebreak.WithLinkAware(true),
+		linebreak.WithFillMode(linebreak.FillOptimal))
+	assert.Equal(t, lines, []string{"aaa bbb", "www.example.com/", "a cc dd"})
+}
@@ -0,0 +1,367 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"sort"
+	"unicode"
+)
+
+// FillMode is the enum type for the way to choose line breaks in a paragraph.
+type FillMode int
+
+const (
+	// FillGreedy is the mode which breaks a line at the last line break
+	// opportunity which fits the line width, line by line.
+	// This is the default mode.
+	FillGreedy FillMode = iota
+
+	// FillOptimal is the mode which chooses the line breaks of a whole
+	// paragraph so that the sum of the squares of the spaces left at the ends
+	// of the lines and the penalties is minimized, as the total-fit algorithm
+	// of Knuth and Plass.
+	// A paragraph is a part of the text separated by mandatory line breaks,
+	// and is buffered until its end.
+	FillOptimal
+)
+
+// Penalties is the struct that holds the penalties which are added to the
+// score of line breaks in FillOptimal mode.
+// Hyphen is added for each line broken in a word with a hyphen.
+// Widow is added when the last line of a paragraph has only one word.
+// Orphan is added when the first line of a paragraph, which has more than
+// one line, has only one word.
+type Penalties struct {
+	Hyphen int
+	Widow  int
+	Orphan int
+}
+
+// DefaultPenalties is the function that returns the penalties which are used
+// when no penalties are set.
+func DefaultPenalties() Penalties {
+	return Penalties{Hyphen: 50, Widow: 100, Orphan: 100}
+}
+
+// The cost of a line which overflows the line width.
+const overfullCost = 1 << 20
+
+// The cost of a line broken in a link in the link aware mode, which is larger
+// than the cost of any line which fits the line width.
+const linkBreakCost = 1 << 16
+
+// fitTab is the struct that holds a tab in a paragraph, of which the width is
+// estimated with the column in the paragraph as if it were not broken.
+type fitTab struct {
+	w0 int // width from the paragraph start to the tab
+	w  int // estimated width of the tab
+}
+
+// fitCand is the struct that holds a candidate of a line break in a
+// paragraph.
+type fitCand struct {
+	pos    int  // position in the buffer
+	wEnd   int  // width from the paragraph start to the end of a line
+	wNext  int  // width from the paragraph start to the start of the next line
+	hyphen bool // whether a hyphen is put when broken at this candidate
+	link   bool // whether this candidate is in a link
+}
+
+// addFitCand is the method to add a candidate of a line break at the specified
+// position, which is the end of the buffer.
+func (iter *LineIter) addFitCand(pos int, hyphen bool) {
+	wEnd := iter.width[0] + iter.width[1]
+	iter.cands = append(iter.cands, fitCand{
+		pos:    pos,
+		wEnd:   wEnd,
+		wNext:  wEnd + iter.width[2],
+		hyphen: hyphen,
+	})
+}
+
+// addWordFitCands is the method to add the hyphenation points of the last
+// word in the buffer as candidates of line breaks.
+func (iter *LineIter) addWordFitCands() {
+	word := make([]rune, 0, iter.buffer.length-iter.wordStart)
+	index := make([]int, 0, cap(word))
+	for i := iter.wordStart; i < iter.buffer.length; i++ {
+		if iter.buffer.widths[i] > 0 {
+			word = append(word, iter.buffer.runes[i])
+			index = append(index, i)
+		}
+	}
+
+	for _, p := range iter.hyphenator.Hyphenate(string(word)) {
+		pos := index[p]
+		w := iter.wordStartW + iter.widthOf(iter.wordStart, pos)
+		c := fitCand{pos: pos, wEnd: w, wNext: w, hyphen: true}
+
+		// soft hyphens in the word can be after this point.
+		i := len(iter.cands)
+		iter.cands = append(iter.cands, c)
+		for ; i > 0 && iter.cands[i-1].pos > pos; i-- {
+			iter.cands[i] = iter.cands[i-1]
+		}
+		iter.cands[i] = c
+	}
+}
+
+// breakParagraph is the method to choose the line breaks of the paragraph in
+// the buffer, and to add the lines except the last line to the pending lines.
+// The last line is left in the buffer.
+func (iter *LineIter) breakParagraph() {
+	if len(iter.cands) > 0 {
+		// the lines are removed from the buffer by skipping them, and the rest
+		// of the buffer is moved once after all lines are output.
+		orig := iter.buffer
+		consumed := 0
+		for _, k := range iter.optimalBreaks() {
+			c := iter.cands[k]
+			end := end_wrap
+			if c.hyphen {
+				end = end_hyphen
+			}
+			iter.emitLine(c.pos-consumed, end)
+			consumed = c.pos
+		}
+		iter.buffer.unskip(orig)
+		iter.cands = iter.cands[:0]
+	}
+
+	iter.retab(iter.buffer.length)
+
+	iter.width[0] = 0
+	iter.width[1] = iter.contentWidth(iter.buffer.length)
+	iter.width[2] = iter.widthOf(0, iter.buffer.length) - iter.width[1]
+	for {
+		limit := iter.lineLimit()
+		if iter.width[1] <= limit {
+			break
+		}
+		iter.pendings = append(iter.pendings, iter.cutByWidth(limit))
+	}
+}
+
+// optimalBreaks is the method that returns the indexes of the candidates at
+// which the lines of the paragraph are broken.
+func (iter *LineIter) optimalBreaks() []int {
+	cands := iter.cands
+	n := len(cands)
+	last := n + 1
+
+	firstLimit := iter.lineLimit()
+	nextLimit := iter.limit - iter.indentOf(line_continuation).width -
+		iter.decor.width
+
+	// the widths of the tabs are computed again for each line, and a line is
+	// regarded as too wide to be shortened only if it is wider than the limit
+	// by more than the difference which the tabs can make.
+	tabs := iter.fitTabs()
+	tabSlack := 0
+	if len(tabs) > 0 {
+		tabSlack = iter.tabWidth - 1
+	}
+	firstIndent := iter.currentIndent().width
+	nextIndent := iter.indentOf(line_continuation).width
+
+	// the count of the candidates which are not hyphenation points before
+	// each node.
+	words := make([]int, n+2)
+	for k := 1; k <= last; k++ {
+		words[k] = words[k-1]
+		if k > 1 && !cands[k-2].hyphen && !cands[k-2].link {
+			words[k]++
+		}
+	}
+
+	costs := make([]int, n+2)
+	prevs := make([]int, n+2)
+	for j := 1; j <= last; j++ {
+		costs[j] = -1
+
+		var wEnd int
+		var hyphen, link bool
+		if j == last {
+			wEnd = iter.width[0] + iter.width[1]
+		} else {
+			wEnd = cands[j-1].wEnd
+			hyphen = cands[j-1].hyphen
+			link = cands[j-1].link
+		}
+
+		for i := j - 1; i >= 0; i-- {
+			if costs[i] < 0 {
+				continue
+			}
+
+			wNext, limit, indent := 0, firstLimit, firstIndent
+			if i > 0 {
+				wNext, limit, indent = cands[i-1].wNext, nextLimit, nextIndent
+			}
+			w := wEnd - wNext
+			if hyphen {
+				w++
+			}
+			if w-tabSlack > limit && i < j-1 {
+				break
+			}
+			if len(tabs) > 0 {
+				w += tabDelta(tabs, wNext, wEnd, indent, iter.tabWidth)
+			}
+
+			cost := 0
+			if w > limit {
+				cost = overfullCost * (w - limit)
+			} else if j < last {
+				cost = (limit - w) * (limit - w)
+			}
+			if hyphen {
+				cost += iter.penalties.Hyphen
+			}
+			if link {
+				cost += linkBreakCost
+			}
+			singleWord := (words[j] == words[i+1])
+			if singleWord && j == last && i > 0 {
+				cost += iter.penalties.Widow
+			}
+			if singleWord && i == 0 && j < last {
+				cost += iter.penalties.Orphan
+			}
+
+			cost += costs[i]
+			if costs[j] < 0 || cost < costs[j] {
+				costs[j] = cost
+				prevs[j] = i
+			}
+		}
+	}
+
+	breaks := make([]int, 0, 8)
+	for k := prevs[last]; k > 0; k = prevs[k] {
+		breaks = append(breaks, k-1)
+	}
+	for i, j := 0, len(breaks)-1; i < j; i, j = i+1, j-1 {
+		breaks[i], breaks[j] = breaks[j], breaks[i]
+	}
+	return breaks
+}
+
+// fitTabs is the method that returns the tabs in the paragraph in the buffer,
+// of which the widths are counted in the tab mode.
+func (iter *LineIter) fitTabs() []fitTab {
+	if iter.tabMode == TabIgnore {
+		return nil
+	}
+	var tabs []fitTab
+	w := 0
+	for i := 0; i < iter.buffer.length; i++ {
+		clusterW := iter.buffer.widths[i]
+		if clusterW <= 0 {
+			continue
+		}
+		if iter.buffer.runes[i] == tab {
+			tabs = append(tabs, fitTab{w0: w, w: clusterW})
+		}
+		w += clusterW
+	}
+	return tabs
+}
+
+// tabDelta is the function that returns the difference between the actual
+// widths and the estimated widths of the tabs in a line, which is the part of
+// the paragraph from the width start to the width end and is put after an
+// indentation of the width indent.
+func tabDelta(tabs []fitTab, start, end, indent, tabWidth int) int {
+	k := sort.Search(len(tabs), func(k int) bool {
+		return tabs[k].w0 >= start
+	})
+	delta := 0
+	for ; k < len(tabs) && tabs[k].w0 < end; k++ {
+		col := indent + tabs[k].w0 - start + delta
+		delta += tabWidthAt(col, tabWidth) - tabs[k].w
+	}
+	return delta
+}
+
+// retab is the method to compute again the widths of the tabs in the runes
+// before the specified position in the buffer, which are the head of a line.
+func (iter *LineIter) retab(pos int) {
+	if iter.tabMode == TabIgnore {
+		return
+	}
+	col := iter.currentIndent().width
+	for i := 0; i < pos; i++ {
+		clusterW := iter.buffer.widths[i]
+		if clusterW <= 0 {
+			continue
+		}
+		if iter.buffer.runes[i] == tab {
+			clusterW = tabWidthAt(col, iter.tabWidth)
+			iter.buffer.widths[i] = clusterW
+		}
+		col += clusterW
+	}
+}
+
+// emitLine is the method to add the line which consists of the runes before
+// the specified position in the buffer to the pending lines, and to skip the
+// runes in the buffer.
+// If the line is wider than the line width, it is broken forcely.
+func (iter *LineIter) emitLine(pos int, end lineEnd) {
+	for {
+		limit := iter.lineLimit()
+		iter.retab(pos)
+		w := iter.contentWidth(pos)
+		if end == end_hyphen {
+			w++
+		}
+		if w <= limit {
+			break
+		}
+
+		i, lineW := 0, 0
+		for ; i < pos; i++ {
+			clusterW := iter.buffer.widths[i]
+			if clusterW < 0 {
+				continue
+			}
+			if lineW+clusterW > limit && i > 0 {
+				break
+			}
+			lineW += clusterW
+		}
+		if i >= pos {
+			break
+		}
+
+		cut, cutEnd := iter.forcedBreak(i, lineW, limit, iter.buffer.runes[i])
+		iter.pendings = append(iter.pendings, iter.lineOf(cut, cutEnd))
+		iter.skipBuffer(cut)
+		iter.lineKind = line_continuation
+		pos -= cut
+	}
+
+	iter.pendings = append(iter.pendings, iter.lineOf(pos, end))
+	iter.skipBuffer(pos)
+	iter.lineKind = line_continuation
+}
+
+// contentWidth is the method that returns the display width of the runes
+// before the specified position in the buffer, excluding the trailing spaces.
+func (iter *LineIter) contentWidth(pos int) int {
+	w, content := 0, 0
+	for i := 0; i < pos; i++ {
+		clusterW := iter.buffer.widths[i]
+		if clusterW <= 0 {
+			continue
+		}
+		w += clusterW
+		if !unicode.IsSpace(iter.buffer.runes[i]) {
+			content = w
+		}
+	}
+	return content
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+// Option is the type of a function which sets an option of a LineIter.
+// An option is applied with the same setter method of LineIter.
+type Option func(iter *LineIter)
+
+// WithIndent is the function that returns an Option to set an indentation.
+// See LineIter.SetIndent.
+func WithIndent(indent string) Option {
+	return func(iter *LineIter) {
+		iter.SetIndent(indent)
+	}
+}
+
+// WithFirstIndent is the function that returns an Option to set an
+// indentation for the first line.
+// See LineIter.SetFirstIndent.
+func WithFirstIndent(indent string) Option {
+	return func(iter *LineIter) {
+		iter.SetFirstIndent(indent)
+	}
+}
+
+// WithContinuationIndent is the function that returns an Option to set an
+// indentation for the lines which follow a line broken by wrapping, that is,
+// a hanging indent.
+// See LineIter.SetContinuationIndent.
+func WithContinuationIndent(indent string) Option {
+	return func(iter *LineIter) {
+		iter.SetContinuationIndent(indent)
+	}
+}
+
+// WithParagraphIndent is the function that returns an Option to set an
+// indentation for the lines which follow a mandatory line break.
+// See LineIter.SetParagraphIndent.
+func WithParagraphIndent(indent string) Option {
+	return func(iter *LineIter) {
+		iter.SetParagraphIndent(indent)
+	}
+}
+
+// WithAlignment is the function that returns an Option to set the alignment
+// of lines.
+// See LineIter.SetAlignment.
+func WithAlignment(align Alignment) Option {
+	return func(iter *LineIter) {
+		iter.SetAlignment(align)
+	}
+}
+
+// WithHyphenator is the function that returns an Option to set a Hyphenator.
+// See LineIter.SetHyphenator.
+func WithHyphenator(h *Hyphenator) Option {
+	return func(iter *LineIter) {
+		iter.SetHyphenator(h)
+	}
+}
+
+// WithForcedBreakHyphen is the function that returns an Option to set whether
+// a hyphen is put at the end of a line which is broken forcely in a word.
+// See LineIter.SetForcedBreakHyphen.
+func WithForcedBreakHyphen(on bool) Option {
+	return func(iter *LineIter) {
+		iter.SetForcedBreakHyphen(on)
+	}
+}
+
+// WithFillMode is the function that returns an Option to set the way to
+// choose line breaks in a paragraph.
+// See LineIter.SetFillMode.
+func WithFillMode(mode FillMode) Option {
+	return func(iter *LineIter) {
+		iter.SetFillMode(mode)
+	}
+}
+
+// WithPenalties is the function that returns an Option to set the penalties
+// which are used in FillOptimal mode.
+// See LineIter.SetPenalties.
+func WithPenalties(p Penalties) Option {
+	return func(iter *LineIter) {
+		iter.SetPenalties(p)
+	}
+}
+
+// WithTabMode is the function that returns an Option to set the way to handle
+// horizontal tabs.
+// See LineIter.SetTabMode.
+func WithTabMode(mode TabMode) Option {
+	return func(iter *LineIter) {
+		iter.SetTabMode(mode)
+	}
+}
+
+// WithTabWidth is the function that returns an Option to set the interval of
+// tab stops.
+// See LineIter.SetTabWidth.
+func WithTabWidth(width int) Option {
+	return func(iter *LineIter) {
+		iter.SetTabWidth(width)
+	}
+}
+
+// WithWhitespaceMode is the function that returns an Option to set the way to
+// handle white spaces.
+// See LineIter.SetWhitespaceMode.
+func WithWhitespaceMode(mode WhitespaceMode) Option {
+	return func(iter *LineIter) {
+		iter.SetWhitespaceMode(mode)
+	}
+}
+
+// WithPrefixFunc is the function that returns an Option to set a function
+// which returns a prefix of each line.
+// See LineIter.SetPrefixFunc.
+func WithPrefixFunc(fn func(info LineInfo) string) Option {
+	return func(iter *LineIter) {
+		iter.SetPrefixFunc(fn)
+	}
+}
+
+// WithSuffixFunc is the function that returns an Option to set a function
+// which returns a suffix of each line.
+// See LineIter.SetSuffixFunc.
+func WithSuffixFunc(fn func(info LineInfo) string) Option {
+	return func(iter *LineIter) {
+		iter.SetSuffixFunc(fn)
+	}
+}
+
+// WithWidthMeasurer is the function that returns an Option to set the
+// WidthMeasurer.
+// See LineIter.SetWidthMeasurer.
+func WithWidthMeasurer(m WidthMeasurer) Option {
+	return func(iter *LineIter) {
+		iter.SetWidthMeasurer(m)
+	}
+}
+
+// WithAmbiguousWidth is the function that returns an Option to set the
+// display width of East-Asian-Width Ambiguous runes, which is 1 or 2.
+// This is a shorthand of WithWidthMeasurer(NewWidthMeasurer(width)).
+func WithAmbiguousWidth(width int) Option {
+	return WithWidthMeasurer(NewWidthMeasurer(width))
+}
+
+// WithRules is the function that returns an Option to set the rules which
+// tailor line break opportunities.
+// See LineIter.SetRules.
+func WithRules(rules Rules) Option {
+	return func(iter *LineIter) {
+		iter.SetRules(rules)
+	}
+}
+
+// WithLinkAware is the function that returns an Option to enable or disable
+// the link aware mode.
+// See LineIter.SetLinkAware.
+func WithLinkAware(on bool) Option {
+	return func(iter *LineIter) {
+		iter.SetLinkAware(on)
+	}
+}
+
+// WithKinsokuStrategy is the function that returns an Option to set the way
+// to avoid a rune which cannot be at the head of a line.
+// See LineIter.SetKinsokuStrategy.
+func WithKinsokuStrategy(strategy KinsokuStrategy) Option {
+	return func(iter *LineIter) {
+		iter.SetKinsokuStrategy(strategy)
+	}
+}
+
+// WithBreakAlgorithm is the function that returns an Option to set the
+// algorithm to find line break opportunities.
+// See LineIter.SetBreakAlgorithm.
+func WithBreakAlgorithm(alg BreakAlgorithm) Option {
+	return func(iter *LineIter) {
+		iter.SetBreakAlgorithm(alg)
+	}
+}
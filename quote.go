@@ -0,0 +1,127 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"strings"
+)
+
+// The opening quotes and the closing quotes which are paired with them.
+// Some quotes are opening or closing depending on the locale, for example,
+// "“" opens a quote in English, and closes "„" in German.
+var quotePairs = map[rune]string{
+	0x0022: "\"", // "..."
+	0x0027: "'’", // '...'
+	0x2018: "’",  // ‘...’
+	0x201C: "”",  // “...”
+	0x201E: "“”", // „...“ and „...”
+	0x201A: "‘’", // ‚...‘ and ‚...’
+	0x00AB: "»",  // «...»
+	0x00BB: "«",  // »...«
+	0x2039: "›",  // ‹...›
+	0x203A: "‹",  // ›...‹
+}
+
+// The closing quotes which are not paired with any opening quote.
+const closingQuotes = "’”"
+
+// The maximum depth of the nested quotes which are tracked.
+const maxQuoteDepth = 8
+
+// isApostrophe is the function that returns true if the specified rune can be
+// an apostrophe, such as in "don't" and "it’s".
+func isApostrophe(r rune) bool {
+	return r == 0x27 || r == 0x2019
+}
+
+// isQuote is the function that returns true if the specified rune is a quote.
+func isQuote(r rune) bool {
+	if _, ok := quotePairs[r]; ok {
+		return true
+	}
+	return contains([]rune(closingQuotes), r)
+}
+
+// The punctuations which are put after a space with French spacing.
+const spacedPuncts = "?!:;"
+
+// isSpacedClosing is the function that returns true if the specified rune,
+// which cannot be at the head of a line, can be put after a space, as a
+// closing quote and a punctuation with French spacing.
+func isSpacedClosing(r rune) bool {
+	return isQuote(r) || strings.ContainsRune(spacedPuncts, r)
+}
+
+// resolveQuote is the method to determine whether the previous rune, which is
+// a quote or an apostrophe after a letter, is a closing quote or an
+// apostrophe by the specified rune following it.
+// It is an apostrophe if the specified rune is a letter or a digit, as
+// "don't", and otherwise a closing quote.
+func (state *lboState) resolveQuote(r rune) {
+	if state.pendingQuote == 0 {
+		return
+	}
+	i := state.pendingQuote - 1
+	state.pendingQuote = 0
+
+	if !isWordChar(r) {
+		state.quotes = state.quotes[:i]
+		state.lboPrev = lbo_after
+	}
+}
+
+// quote is the method to set the line break opportunity type of the specified
+// rune if it is a quote, and returns true in that case.
+// An opening quote cannot be at the end of a line, and a closing quote cannot
+// be at the head of a line. An apostrophe is treated as a letter.
+func (state *lboState) quote(r rune) bool {
+	if !isQuote(r) {
+		return false
+	}
+	afterWord := isWordChar(state.prev[0])
+
+	if i := state.openQuoteIndex(r); i >= 0 {
+		if isApostrophe(r) && afterWord {
+			state.pendingQuote = i + 1
+			state.lboType = lbo_never
+			return true
+		}
+		state.quotes = state.quotes[:i]
+		state.lboType = lbo_after
+		return true
+	}
+
+	switch {
+	case isApostrophe(r) && afterWord:
+		state.lboType = lbo_never
+	case contains([]rune(closingQuotes), r),
+		afterWord && r != 0x22: // a straight double quote opens after a word.
+		state.lboType = lbo_after
+	default:
+		if len(state.quotes) >= maxQuoteDepth {
+			state.quotes = append(state.quotes[:0], state.quotes[1:]...)
+		}
+		state.quotes = append(state.quotes, r)
+		state.lboType = lbo_before
+	}
+	return true
+}
+
+// openQuoteIndex is the method that returns the index of the innermost open
+// quote which is closed by the specified rune, or -1 if there is no such quote.
+func (state *lboState) openQuoteIndex(r rune) int {
+	for i := len(state.quotes) - 1; i >= 0; i-- {
+		if contains([]rune(quotePairs[state.quotes[i]]), r) {
+			return i
+		}
+	}
+	return -1
+}
+
+// clearQuotes is the method to close all open quotes.
+func (state *lboState) clearQuotes() {
+	state.quotes = state.quotes[:0]
+	state.pendingQuote = 0
+}
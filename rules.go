@@ -0,0 +1,254 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"unicode"
+)
+
+// Rules is the struct that holds the rules which tailor line break
+// opportunities for a language: runes which cannot be at the head of a line
+// ("no break before"), runes which cannot be at the end of a line ("no break
+// after"), and whether lines are broken only at spaces.
+// A Rules is immutable. The methods to add or remove runes return a new Rules
+// and do not change the receiver, so that a Rules can be shared by multiple
+// LineIter instances and goroutines.
+// The zero value has no rules.
+type Rules struct {
+	noBreakBefore runeSet
+	noBreakAfter  runeSet
+	keepAll       bool
+	emDash        DashBreak
+}
+
+var defaultRules = Rules{
+	noBreakBefore: newRuneSet(lboAfters),
+	noBreakAfter:  newRuneSet(lboBefores),
+}
+
+// DefaultRules is the function that returns the rules which are used by
+// BreakBasic when no rules are set. These consist of a small set of
+// punctuations for English and Japanese.
+func DefaultRules() Rules {
+	return defaultRules
+}
+
+// ASCIIRules is the function that returns the rules for plain ASCII
+// punctuations: closing brackets and punctuation marks cannot be at the head
+// of a line, and opening brackets cannot be at the end of a line.
+func ASCIIRules() Rules {
+	return Rules{
+		noBreakBefore: newRuneSet([]rune(asciiNoBreakBefore)),
+		noBreakAfter:  newRuneSet([]rune(asciiNoBreakAfter)),
+	}
+}
+
+// StrictJapaneseRules is the function that returns the strict kinsoku rules
+// for Japanese, with which small kana, prolonged sound marks and iteration
+// marks as well as closing brackets and punctuations cannot be at the head of
+// a line.
+func StrictJapaneseRules() Rules {
+	return Rules{
+		noBreakBefore: newRuneSet([]rune(jaStrictNoBreakBefore)),
+		noBreakAfter:  newRuneSet([]rune(jaNoBreakAfter)),
+	}
+}
+
+// LooseJapaneseRules is the function that returns the loose kinsoku rules for
+// Japanese, with which small kana, prolonged sound marks and iteration marks
+// can be at the head of a line.
+func LooseJapaneseRules() Rules {
+	return Rules{
+		noBreakBefore: newRuneSet([]rune(jaLooseNoBreakBefore)),
+		noBreakAfter:  newRuneSet([]rune(jaNoBreakAfter)),
+	}
+}
+
+// SimplifiedChineseRules is the function that returns the rules for
+// Simplified Chinese.
+func SimplifiedChineseRules() Rules {
+	return Rules{
+		noBreakBefore: newRuneSet([]rune(zhHansNoBreakBefore)),
+		noBreakAfter:  newRuneSet([]rune(zhHansNoBreakAfter)),
+	}
+}
+
+// TraditionalChineseRules is the function that returns the rules for
+// Traditional Chinese.
+func TraditionalChineseRules() Rules {
+	return Rules{
+		noBreakBefore: newRuneSet([]rune(zhHantNoBreakBefore)),
+		noBreakAfter:  newRuneSet([]rune(zhHantNoBreakAfter)),
+	}
+}
+
+// KoreanRules is the function that returns the rules for Korean, with which
+// lines are broken at spaces between words instead of between every Hangul
+// syllable.
+func KoreanRules() Rules {
+	return Rules{
+		noBreakBefore: newRuneSet([]rune(koNoBreakBefore)),
+		noBreakAfter:  newRuneSet([]rune(koNoBreakAfter)),
+		keepAll:       true,
+	}
+}
+
+// AddNoBreakBefore is the method that returns a new Rules in which the
+// specified runes cannot be at the head of a line.
+func (rules Rules) AddNoBreakBefore(runes ...rune) Rules {
+	rules.noBreakBefore = rules.noBreakBefore.with(runes, true)
+	return rules
+}
+
+// RemoveNoBreakBefore is the method that returns a new Rules in which the
+// specified runes can be at the head of a line.
+func (rules Rules) RemoveNoBreakBefore(runes ...rune) Rules {
+	rules.noBreakBefore = rules.noBreakBefore.with(runes, false)
+	return rules
+}
+
+// AddNoBreakAfter is the method that returns a new Rules in which the
+// specified runes cannot be at the end of a line.
+func (rules Rules) AddNoBreakAfter(runes ...rune) Rules {
+	rules.noBreakAfter = rules.noBreakAfter.with(runes, true)
+	return rules
+}
+
+// RemoveNoBreakAfter is the method that returns a new Rules in which the
+// specified runes can be at the end of a line.
+func (rules Rules) RemoveNoBreakAfter(runes ...rune) Rules {
+	rules.noBreakAfter = rules.noBreakAfter.with(runes, false)
+	return rules
+}
+
+// KeepAll is the method that returns a new Rules in which lines are broken or
+// not broken between East Asian letters, such as Hangul syllables and Han
+// ideographs. If the argument is true, lines are broken only at spaces and
+// punctuations, as word-based breaking of Korean.
+func (rules Rules) KeepAll(on bool) Rules {
+	rules.keepAll = on
+	return rules
+}
+
+// EmDashBreak is the method that returns a new Rules in which the line break
+// opportunities around em dashes are the specified mode.
+func (rules Rules) EmDashBreak(mode DashBreak) Rules {
+	rules.emDash = mode
+	return rules
+}
+
+// breaksAroundEmDash is the method that returns whether a line can be broken
+// before and after an em dash.
+func (rules Rules) breaksAroundEmDash() (before, after bool) {
+	switch rules.emDash {
+	case DashBreakBefore:
+		return true, false
+	case DashBreakAfter:
+		return false, true
+	case DashBreakNever:
+		return false, false
+	}
+	return true, true
+}
+
+// IsNoBreakBefore is the method that returns true if the specified rune
+// cannot be at the head of a line.
+func (rules Rules) IsNoBreakBefore(r rune) bool {
+	_, ok := rules.noBreakBefore[r]
+	return ok
+}
+
+// IsNoBreakAfter is the method that returns true if the specified rune cannot
+// be at the end of a line.
+func (rules Rules) IsNoBreakAfter(r rune) bool {
+	_, ok := rules.noBreakAfter[r]
+	return ok
+}
+
+// keepsTogether is the method that returns true if a line is not broken
+// between the specified runes by the keep-all rule.
+func (rules Rules) keepsTogether(prev, r rune) bool {
+	return rules.keepAll && isKeepAllLetter(prev) && isKeepAllLetter(r)
+}
+
+// isKeepAllLetter is the function that returns true if the specified rune is
+// an East Asian letter which is kept together with its neighbors by the
+// keep-all rule.
+func isKeepAllLetter(r rune) bool {
+	return unicode.IsLetter(r) && isEastAsian(r)
+}
+
+// DashBreak is the enum type for the line break opportunities around em
+// dashes, such as "—" (U+2014) and "⸺" (U+2E3A).
+// A line is never broken between em dashes.
+type DashBreak int
+
+const (
+	// DashBreakBoth is the mode which allows line breaks before and after an
+	// em dash.
+	// This is the default mode.
+	DashBreakBoth DashBreak = iota
+
+	// DashBreakBefore is the mode which allows a line break only before an em
+	// dash, so that an em dash is kept with the following word.
+	DashBreakBefore
+
+	// DashBreakAfter is the mode which allows a line break only after an em
+	// dash, so that an em dash is kept with the preceding word.
+	DashBreakAfter
+
+	// DashBreakNever is the mode which allows no line break around an em dash.
+	DashBreakNever
+)
+
+// KinsokuStrategy is the enum type for the way to avoid a rune which cannot
+// be at the head of a line, such as "。", when it overflows a line.
+type KinsokuStrategy int
+
+const (
+	// KinsokuOidashi is the strategy which pushes out the preceding rune to the
+	// next line together with the prohibited rune.
+	// This is the default strategy.
+	KinsokuOidashi KinsokuStrategy = iota
+
+	// KinsokuBurasage is the strategy which lets one punctuation, such as "、"
+	// and "。", hang beyond the end of the line. Other prohibited runes are
+	// handled with oidashi.
+	KinsokuBurasage
+
+	// KinsokuOikomi is the strategy which pushes one prohibited rune into the
+	// end of the line. Since the runes in a terminal cannot be compressed, the
+	// line exceeds the line width by the width of the rune. When more than one
+	// prohibited rune overflows, they are handled with oidashi.
+	KinsokuOikomi
+)
+
+// runeSet is the set type of runes.
+type runeSet map[rune]struct{}
+
+func newRuneSet(runes []rune) runeSet {
+	set := make(runeSet, len(runes))
+	for _, r := range runes {
+		set[r] = struct{}{}
+	}
+	return set
+}
+
+// with is the method that returns a copy of this set to which the specified
+// runes are added, or from which they are removed.
+func (set runeSet) with(runes []rune, add bool) runeSet {
+	newSet := make(runeSet, len(set)+len(runes))
+	for r := range set {
+		newSet[r] = struct{}{}
+	}
+	for _, r := range runes {
+		if add {
+			newSet[r] = struct{}{}
+		} else {
+			delete(newSet, r)
+		}
+	}
+	return newSet
+}
@@ -0,0 +1,125 @@
+package linebreak_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sttk/linebreak"
+)
+
+func TestRules_japanese(t *testing.T) {
+	text := "シャッターチャンスを逃すな。"
+
+	lines := linebreak.WrapLines(text, 8,
+		linebreak.WithRules(linebreak.StrictJapaneseRules()))
+	assert.Equal(t, lines, []string{"シャッ", "ターチャ", "ンスを逃", "すな。"})
+
+	lines = linebreak.WrapLines(text, 8,
+		linebreak.WithRules(linebreak.LooseJapaneseRules()))
+	assert.Equal(t, lines, []string{"シャッタ", "ーチャン", "スを逃す", "な。"})
+}
+
+func TestRules_chinese(t *testing.T) {
+	text := "一二三四，五六"
+
+	lines := linebreak.WrapLines(text, 8)
+	assert.Equal(t, lines, []string{"一二三四", "，五六"})
+
+	lines = linebreak.WrapLines(text, 8,
+		linebreak.WithRules(linebreak.SimplifiedChineseRules()))
+	assert.Equal(t, lines, []string{"一二三", "四，五六"})
+
+	lines = linebreak.WrapLines(text, 8,
+		linebreak.WithRules(linebreak.TraditionalChineseRules()))
+	assert.Equal(t, lines, []string{"一二三", "四，五六"})
+}
+
+func TestRules_korean(t *testing.T) {
+	text := "대한민국의 수도는 서울입니다."
+
+	lines := linebreak.WrapLines(text, 12)
+	assert.Equal(t, lines, []string{"대한민국의", "수도는 서울", "입니다."})
+
+	lines = linebreak.WrapLines(text, 12,
+		linebreak.WithRules(linebreak.KoreanRules()))
+	assert.Equal(t, lines, []string{"대한민국의", "수도는", "서울입니다."})
+
+	lines = linebreak.WrapLines(text, 12,
+		linebreak.WithBreakAlgorithm(linebreak.BreakUAX14),
+		linebreak.WithRules(linebreak.KoreanRules()))
+	assert.Equal(t, lines, []string{"대한민국의", "수도는", "서울입니다."})
+}
+
+func TestRules_ascii(t *testing.T) {
+	text := "path a/b/c/d"
+
+	lines := linebreak.WrapLines(text, 8)
+	assert.Equal(t, lines, []string{"path a/", "b/c/d"})
+
+	lines = linebreak.WrapLines(text, 8,
+		linebreak.WithRules(linebreak.ASCIIRules()))
+	assert.Equal(t, lines, []string{"path", "a/b/c/d"})
+}
+
+func TestRules_addAndRemove(t *testing.T) {
+	rules := linebreak.ASCIIRules()
+	added := rules.AddNoBreakBefore('%').AddNoBreakAfter('$')
+	removed := added.RemoveNoBreakBefore('%', '!').RemoveNoBreakAfter('(')
+
+	assert.False(t, rules.IsNoBreakBefore('%'))
+	assert.False(t, rules.IsNoBreakAfter('$'))
+	assert.True(t, rules.IsNoBreakBefore('!'))
+	assert.True(t, rules.IsNoBreakAfter('('))
+
+	assert.True(t, added.IsNoBreakBefore('%'))
+	assert.True(t, added.IsNoBreakAfter('$'))
+	assert.True(t, added.IsNoBreakBefore('!'))
+
+	assert.False(t, removed.IsNoBreakBefore('%'))
+	assert.False(t, removed.IsNoBreakBefore('!'))
+	assert.True(t, removed.IsNoBreakAfter('$'))
+	assert.False(t, removed.IsNoBreakAfter('('))
+}
+
+func TestRules_uax14Tailoring(t *testing.T) {
+	text := "今日は晴れ。"
+
+	lines := linebreak.WrapLines(text, 10,
+		linebreak.WithBreakAlgorithm(linebreak.BreakUAX14))
+	assert.Equal(t, lines, []string{"今日は晴", "れ。"})
+
+	rules := linebreak.Rules{}.AddNoBreakBefore('れ')
+	lines = linebreak.WrapLines(text, 10,
+		linebreak.WithBreakAlgorithm(linebreak.BreakUAX14),
+		linebreak.WithRules(rules))
+	assert.Equal(t, lines, []string{"今日は", "晴れ。"})
+}
+
+func TestRules_EmDashBreak(t *testing.T) {
+	text := "wait—what——no"
+
+	for _, alg := range []linebreak.BreakAlgorithm{
+		linebreak.BreakBasic, linebreak.BreakUAX14,
+	} {
+		lines := linebreak.WrapLines(text, 12, linebreak.WithBreakAlgorithm(alg))
+		assert.Equal(t, lines, []string{"wait—what", "——no"})
+
+		rules := linebreak.DefaultRules().EmDashBreak(linebreak.DashBreakBefore)
+		lines = linebreak.WrapLines(text, 12,
+			linebreak.WithBreakAlgorithm(alg), linebreak.WithRules(rules))
+		assert.Equal(t, lines, []string{"wait—what", "——no"})
+
+		rules = linebreak.DefaultRules().EmDashBreak(linebreak.DashBreakAfter)
+		lines = linebreak.WrapLines(text, 12,
+			linebreak.WithBreakAlgorithm(alg), linebreak.WithRules(rules))
+		assert.Equal(t, lines, []string{"wait—", "what——no"})
+
+		rules = linebreak.DefaultRules().EmDashBreak(linebreak.DashBreakNever)
+		lines = linebreak.WrapLines(text, 16,
+			linebreak.WithBreakAlgorithm(alg), linebreak.WithRules(rules))
+		assert.Equal(t, lines, []string{"wait—what——no"})
+		lines = linebreak.WrapLines("say wait—what", 12,
+			linebreak.WithBreakAlgorithm(alg), linebreak.WithRules(rules))
+		assert.Equal(t, lines, []string{"say", "wait—what"})
+	}
+}
@@ -4,13 +4,31 @@
 
 package linebreak
 
+// runeBuffer is the struct that holds runes of a line in grapheme cluster
+// units.
+// The widths field holds the display width of the grapheme cluster which
+// starts at the same index, or -1 for the subsequent runes in a cluster.
+// The srcs field holds the positions of the runes in the source text.
 type runeBuffer struct {
 	runes  []rune
+	widths []int
+	srcs   []srcPos
 	length int
 }
 
+// srcPos is the struct that holds the position of a rune in the source text.
+type srcPos struct {
+	offset int // byte offset
+	index  int // rune offset
+	size   int // byte length
+}
+
 func newRuneBuffer(capacity int) runeBuffer {
-	return runeBuffer{runes: make([]rune, capacity)}
+	return runeBuffer{
+		runes:  make([]rune, capacity),
+		widths: make([]int, capacity),
+		srcs:   make([]srcPos, capacity),
+	}
 }
 
 func (rb *runeBuffer) add(runes ...rune) bool {
@@ -20,7 +38,24 @@ func (rb *runeBuffer) add(runes ...rune) bool {
 	}
 	for i, r := range runes {
 		rb.runes[rb.length+i] = r
+		rb.widths[rb.length+i] = RuneWidth(r)
+	}
+	rb.length += n
+	return true
+}
+
+// addCluster is the method to add the runes of a grapheme cluster with its
+// display width.
+func (rb *runeBuffer) addCluster(cluster []rune, width int) bool {
+	n := len(cluster)
+	if rb.length+n > len(rb.runes) {
+		return false
+	}
+	for i, r := range cluster {
+		rb.runes[rb.length+i] = r
+		rb.widths[rb.length+i] = -1
 	}
+	rb.widths[rb.length] = width
 	rb.length += n
 	return true
 }
@@ -37,6 +72,8 @@ func (rb *runeBuffer) cr(start int) {
 	n := rb.length - start
 	for i := 0; i < n; i++ {
 		rb.runes[i] = rb.runes[i+start]
+		rb.widths[i] = rb.widths[i+start]
+		rb.srcs[i] = rb.srcs[i+start]
 	}
 	rb.length = n
 }
@@ -44,3 +81,45 @@ func (rb *runeBuffer) cr(start int) {
 func (rb runeBuffer) full() []rune {
 	return rb.runes[0:rb.length]
 }
+
+// skip is the method to remove the runes before the specified position by
+// advancing the slices over them, which does not move the following runes.
+// The skipped runes are not reused until unskip is called.
+func (rb *runeBuffer) skip(start int) {
+	if start <= 0 {
+		return
+	}
+	if start > rb.length {
+		start = rb.length
+	}
+	rb.runes = rb.runes[start:]
+	rb.widths = rb.widths[start:]
+	rb.srcs = rb.srcs[start:]
+	rb.length -= start
+}
+
+// unskip is the method to move the runes to the head of the specified buffer,
+// which is this buffer before the runes are skipped, and to make this buffer
+// use the whole of its slices again.
+func (rb *runeBuffer) unskip(orig runeBuffer) {
+	n := rb.length
+	copy(orig.runes, rb.runes[0:n])
+	copy(orig.widths, rb.widths[0:n])
+	copy(orig.srcs, rb.srcs[0:n])
+	orig.length = n
+	*rb = orig
+}
+
+func (rb *runeBuffer) grow(n int) {
+	runes := make([]rune, len(rb.runes)*2+n)
+	copy(runes, rb.runes[0:rb.length])
+	rb.runes = runes
+
+	widths := make([]int, len(rb.widths)*2+n)
+	copy(widths, rb.widths[0:rb.length])
+	rb.widths = widths
+
+	srcs := make([]srcPos, len(rb.srcs)*2+n)
+	copy(srcs, rb.srcs[0:rb.length])
+	rb.srcs = srcs
+}
@@ -118,3 +118,34 @@ func TestRuneBuffer_cr(t *testing.T) {
 	assert.Equal(t, cap(rb.runes), 5)
 	assert.Equal(t, rb.full(), []rune{'1', '2', '3', '4', '5'})
 }
+
+func TestRuneBuffer_skipAndUnskip(t *testing.T) {
+	rb := newRuneBuffer(5)
+	rb.addCluster([]rune{'a'}, 1)
+	rb.addCluster([]rune{'b'}, 1)
+	rb.addCluster([]rune{'c', 0x301}, 1)
+	rb.addCluster([]rune{'d'}, 1)
+	orig := rb
+
+	rb.skip(1)
+	assert.Equal(t, rb.full(), []rune{'b', 'c', 0x301, 'd'})
+	assert.Equal(t, rb.widths[0:rb.length], []int{1, 1, -1, 1})
+	assert.Equal(t, rb.length, 4)
+
+	rb.skip(3)
+	assert.Equal(t, rb.full(), []rune{'d'})
+	assert.Equal(t, rb.length, 1)
+	assert.Equal(t, orig.runes[0:2], []rune{'a', 'b'})
+
+	rb.unskip(orig)
+	assert.Equal(t, rb.runes, []rune{'d', 'b', 'c', 0x301, 'd'})
+	assert.Equal(t, rb.widths[0:rb.length], []int{1})
+	assert.Equal(t, rb.length, 1)
+	assert.Equal(t, len(rb.runes), 5)
+
+	rb.skip(2)
+	assert.Equal(t, rb.length, 0)
+	rb.unskip(orig)
+	assert.Equal(t, rb.length, 0)
+	assert.Equal(t, len(rb.runes), 5)
+}
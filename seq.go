@@ -0,0 +1,77 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+//go:build go1.23
+
+package linebreak
+
+import (
+	"iter"
+)
+
+// Lines is the function that returns an iterator over the lines of the given
+// string wrapped by the specified width.
+// The options are applied to the LineIter which outputs the lines.
+func Lines(text string, lineWidth int, opts ...Option) iter.Seq[string] {
+	it := New(text, lineWidth, opts...)
+	return it.Lines()
+}
+
+// NumberedLines is the function that returns an iterator over the pairs of
+// the 1-based line number and the line of the given string wrapped by the
+// specified width.
+// The options are applied to the LineIter which outputs the lines.
+func NumberedLines(
+	text string, lineWidth int, opts ...Option,
+) iter.Seq2[int, string] {
+	it := New(text, lineWidth, opts...)
+	return it.All()
+}
+
+// Lines is the method that returns an iterator over the lines of the given
+// string wrapped with the line width and options of this Config.
+func (cfg Config) Lines(text string) iter.Seq[string] {
+	it := cfg.New(text)
+	return it.Lines()
+}
+
+// All is the method that returns an iterator over the pairs of the 1-based
+// line number and the line of the given string wrapped with the line width
+// and options of this Config.
+func (cfg Config) All(text string) iter.Seq2[int, string] {
+	it := cfg.New(text)
+	return it.All()
+}
+
+// Lines is the method that returns an iterator over the remaining lines of
+// this LineIter.
+// The lines are obtained with Next, so this LineIter advances as the iterator
+// is ranged over.
+func (iter *LineIter) Lines() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for {
+			line, exists := iter.Next()
+			if !exists || !yield(line) {
+				return
+			}
+		}
+	}
+}
+
+// All is the method that returns an iterator over the pairs of the line
+// number and the line of the remaining lines of this LineIter.
+// The line numbers are 1-based and counted from the first line yielded by the
+// returned iterator.
+// The lines are obtained with Next, so this LineIter advances as the iterator
+// is ranged over.
+func (iter *LineIter) All() iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		for n := 1; ; n++ {
+			line, exists := iter.Next()
+			if !exists || !yield(n, line) {
+				return
+			}
+		}
+	}
+}
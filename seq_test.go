@@ -0,0 +1,168 @@
+//go:build go1.23
+
+package linebreak_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sttk/linebreak"
+)
+
+func TestLines(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog."
+
+	lines := []string{}
+	for line := range linebreak.Lines(text, 16) {
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"The quick brown",
+		"fox jumps over",
+		"the lazy dog.",
+	})
+}
+
+func TestLines_break(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog."
+
+	lines := []string{}
+	for line := range linebreak.Lines(text, 16) {
+		lines = append(lines, line)
+		if len(lines) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, lines, []string{
+		"The quick brown",
+		"fox jumps over",
+	})
+}
+
+func TestNumberedLines(t *testing.T) {
+	text := "The quick brown fox\njumps over the lazy dog."
+
+	numbers := []int{}
+	lines := []string{}
+	for n, line := range linebreak.NumberedLines(text, 16) {
+		numbers = append(numbers, n)
+		lines = append(lines, line)
+	}
+	assert.Equal(t, numbers, []int{1, 2, 3, 4})
+	assert.Equal(t, lines, []string{
+		"The quick brown",
+		"fox",
+		"jumps over the",
+		"lazy dog.",
+	})
+}
+
+func TestLines_options(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog."
+
+	lines := []string{}
+	for line := range linebreak.Lines(text, 16, linebreak.WithIndent("> ")) {
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"> The quick",
+		"> brown fox",
+		"> jumps over the",
+		"> lazy dog.",
+	})
+}
+
+func TestNumberedLines_options(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog."
+
+	numbers := []int{}
+	lines := []string{}
+	for n, line := range linebreak.NumberedLines(text, 16,
+		linebreak.WithAlignment(linebreak.AlignRight)) {
+		numbers = append(numbers, n)
+		lines = append(lines, line)
+	}
+	assert.Equal(t, numbers, []int{1, 2, 3})
+	assert.Equal(t, lines, []string{
+		" The quick brown",
+		"  fox jumps over",
+		"   the lazy dog.",
+	})
+}
+
+func TestConfig_Lines(t *testing.T) {
+	cfg := linebreak.NewConfig(16, linebreak.WithIndent("> "))
+
+	lines := []string{}
+	for line := range cfg.Lines("The quick brown fox jumps over the lazy dog.") {
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"> The quick",
+		"> brown fox",
+		"> jumps over the",
+		"> lazy dog.",
+	})
+
+	lines = []string{}
+	for line := range cfg.Lines("abc def") {
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"> abc def"})
+}
+
+func TestConfig_All(t *testing.T) {
+	cfg := linebreak.NewConfig(16, linebreak.WithIndent("> "))
+
+	numbers := []int{}
+	lines := []string{}
+	for n, line := range cfg.All("The quick brown fox\njumps over the lazy dog.") {
+		numbers = append(numbers, n)
+		lines = append(lines, line)
+	}
+	assert.Equal(t, numbers, []int{1, 2, 3, 4})
+	assert.Equal(t, lines, []string{
+		"> The quick",
+		"> brown fox",
+		"> jumps over the",
+		"> lazy dog.",
+	})
+}
+
+func TestLineIter_All(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog."
+	iter := linebreak.New(text, 16)
+	iter.SetIndent("  ")
+
+	line, _ := iter.Next()
+	assert.Equal(t, line, "  The quick")
+
+	lines := []string{}
+	for n, line := range iter.All() {
+		assert.Equal(t, n, len(lines)+1)
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{
+		"  brown fox",
+		"  jumps over the",
+		"  lazy dog.",
+	})
+	assert.False(t, iter.HasNext())
+}
+
+func TestLineIter_Lines(t *testing.T) {
+	iter := linebreak.New("abc def", 3)
+
+	lines := []string{}
+	for line := range iter.Lines() {
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"abc", "def"})
+
+	iter.Init("ghi jkl")
+	lines = []string{}
+	for line := range iter.Lines() {
+		lines = append(lines, line)
+	}
+	assert.Equal(t, lines, []string{"ghi", "jkl"})
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+// TabMode is the enum type for the way to handle horizontal tabs (U+0009) in
+// the text.
+type TabMode int
+
+const (
+	// TabIgnore is the mode which treats a tab as a space of no width.
+	// This is the default mode.
+	TabIgnore TabMode = iota
+
+	// TabExpand is the mode which replaces a tab with spaces up to the next tab
+	// stop.
+	TabExpand
+
+	// TabPreserve is the mode which outputs a tab as it is, and counts its
+	// width as the columns up to the next tab stop.
+	TabPreserve
+)
+
+const (
+	tab = 0x09
+
+	// The default interval of tab stops.
+	defaultTabWidth = 8
+)
+
+// tabWidthAt is the function that returns the width of a tab at the
+// specified column, which is the columns up to the next tab stop.
+func tabWidthAt(col, tabWidth int) int {
+	if tabWidth <= 0 {
+		tabWidth = defaultTabWidth
+	}
+	return tabWidth - col%tabWidth
+}
+
+// expandTabs is the function that recomputes the widths of the tabs in the
+// runes of a line which starts at the specified column.
+// If the expand argument is true, the tabs are replaced with spaces.
+func expandTabs(
+	runes []rune, widths []int, col, tabWidth int, expand bool,
+) ([]rune, []int) {
+	n := 0
+	for i, r := range runes {
+		if r == tab && widths[i] > 0 {
+			n++
+		}
+	}
+	if n == 0 {
+		return runes, widths
+	}
+
+	rs := make([]rune, 0, len(runes)+n*tabWidth)
+	ws := make([]int, 0, cap(rs))
+	for i, r := range runes {
+		w := widths[i]
+		if r != tab || w <= 0 {
+			rs = append(rs, r)
+			ws = append(ws, w)
+			if w > 0 {
+				col += w
+			}
+			continue
+		}
+
+		w = tabWidthAt(col, tabWidth)
+		col += w
+		if !expand {
+			rs = append(rs, r)
+			ws = append(ws, w)
+			continue
+		}
+		for k := 0; k < w; k++ {
+			rs = append(rs, ' ')
+			ws = append(ws, 1)
+		}
+	}
+	return rs, ws
+}
@@ -0,0 +1,575 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"unicode"
+)
+
+// Line break action between two characters of UAX14.
+type lbAction int
+
+const (
+	lba_prohibited lbAction = iota // ×
+	lba_allowed                    // ÷
+	lba_mandatory                  // !
+)
+
+// lbCond is the enum type for the conditions on the following runes, by which
+// some rules of UAX14 determine the line break action before a rune.
+type lbCond int
+
+const (
+	lbcond_none   lbCond = iota // the action is determined
+	lbcond_lb15b                // followed by a closing context or eot
+	lbcond_lb15c                // followed by NU
+	lbcond_lb19a                // followed by a non East Asian rune or eot
+	lbcond_lb25                 // followed by NU or IS NU
+	lbcond_lb25is               // followed by NU after IS
+	lbcond_lb28a                // followed by VF
+)
+
+// lbDecision is the struct that holds a received rune and the line break
+// action before it.
+// If cond is not lbcond_none, the action is not determined yet, and it
+// becomes condAct if the following runes satisfy cond, otherwise act.
+type lbDecision struct {
+	r       rune
+	prev    rune    // the previous rune which is not absorbed by LB9
+	raw     lbClass // class of the rune, resolved only by LB1
+	leadHy  bool    // whether the previous rune is a hyphen not after a word
+	act     lbAction
+	cond    lbCond
+	condAct lbAction
+}
+
+// dottedCircle is the rune which is used as a base of Brahmic scripts by LB28a.
+const dottedCircle = 0x25CC
+
+// uax14State is the struct that determines line break actions by the pair
+// rules (LB1 - LB31) of the Unicode Line Breaking Algorithm (UAX14).
+// This struct receives runes one by one, and queues the actions before them.
+// Since some rules refer to the following runes, the actions are determined
+// in order, possibly some runes later than they are received.
+type uax14State struct {
+	started  bool
+	prev     lbClass // class of the previous rune, resolved by LB1, LB9, LB10
+	prevRune rune
+	beforeSp lbClass // class of the rune before the preceding spaces
+	spaces   bool    // whether the previous rune is SP
+	zwSp     bool    // whether the preceding runes match ZW SP*
+	zwj      bool    // whether the previous rune is ZWJ
+	piQu     bool    // whether the preceding runes match LB15a
+	quHead   bool    // whether the preceding runes match (sot | [^EA]) QU
+	hyHead   bool    // whether the preceding runes match LB20a
+	hlHy     bool    // whether the preceding runes match HL (HY | HH)
+	num      bool    // whether the preceding runes match NU (SY | IS)*
+	numCl    bool    // whether the preceding runes match NU (SY | IS)* (CL | CP)
+	akVi     bool    // whether the preceding runes match (AK | ◌ | AS) VI
+	riOdd    bool    // whether the count of the preceding RIs is odd
+	leadHy   bool    // whether the previous rune is a hyphen not after a word
+	rules    Rules   // rules which tailor the line break opportunities
+	queue    []lbDecision
+	cond     lbCond   // condition found by the rules for the received rune
+	condAct  lbAction // action when the condition is satisfied
+}
+
+func (s *uax14State) reset() {
+	*s = uax14State{rules: s.rules, queue: s.queue[:0]}
+}
+
+func (s *uax14State) setRules(rules Rules) {
+	s.rules = rules
+}
+
+// next is the method to receive the specified rune and to queue the line break
+// action between the previous rune and the received rune.
+func (s *uax14State) next(r rune) {
+	c := resolveLbClass(r)
+
+	d := lbDecision{r: r, prev: s.prevRune, raw: c, leadHy: s.leadHy}
+	s.leadHy = contains(lboHyphens, r) && !isWordChar(s.prevRune)
+
+	if !s.started { // LB2
+		s.started = true
+		s.prev = lbc_BK // the start of text is regarded as BK by the rules.
+		s.update(r, c)
+		d.act = lba_prohibited
+		s.queue = append(s.queue, d)
+		return
+	}
+
+	s.cond = lbcond_none
+	act, absorbed := s.decide(r, c)
+	d.act = act
+	if absorbed { // LB9
+		s.zwj = (c == lbc_ZWJ)
+		s.queue = append(s.queue, d)
+		return
+	}
+	d.cond, d.condAct = s.cond, s.condAct
+
+	s.resolve(r, c, false)
+	s.update(r, c)
+	s.queue = append(s.queue, d)
+}
+
+// end is the method to determine the actions which remain undetermined at the
+// end of text.
+func (s *uax14State) end() {
+	s.resolve(0, lbc_XX, true)
+}
+
+// resolve is the method to determine the queued actions by the specified
+// rune, which is received after them and not absorbed by LB9.
+func (s *uax14State) resolve(r rune, c lbClass, eot bool) {
+	for i := range s.queue {
+		d := &s.queue[i]
+		if d.cond == lbcond_none {
+			continue
+		}
+
+		ok := false
+		switch d.cond {
+		case lbcond_lb15b:
+			switch c {
+			case lbc_SP, lbc_GL, lbc_WJ, lbc_CL, lbc_QU, lbc_CP, lbc_EX, lbc_IS,
+				lbc_SY, lbc_BK, lbc_CR, lbc_LF, lbc_NL, lbc_ZW:
+				ok = true
+			}
+			ok = ok || eot
+		case lbcond_lb15c:
+			ok = !eot && c == lbc_NU
+		case lbcond_lb19a:
+			ok = eot || !isEastAsian(r)
+		case lbcond_lb25:
+			if !eot && c == lbc_IS {
+				d.cond = lbcond_lb25is
+				continue
+			}
+			ok = !eot && c == lbc_NU
+		case lbcond_lb25is:
+			ok = !eot && c == lbc_NU
+		case lbcond_lb28a:
+			ok = !eot && c == lbc_VF
+		}
+
+		if ok {
+			d.act = d.condAct
+		}
+		d.cond = lbcond_none
+	}
+}
+
+// pop is the method that removes the first queued action and returns it if
+// it is determined.
+func (s *uax14State) pop() (lbDecision, bool) {
+	if len(s.queue) == 0 || s.queue[0].cond != lbcond_none {
+		return lbDecision{}, false
+	}
+	d := s.queue[0]
+	n := copy(s.queue, s.queue[1:])
+	s.queue = s.queue[:n]
+	return d, true
+}
+
+// lookahead is the method to record the condition on the following runes for
+// the received rune, by which the action becomes the specified action.
+// Only the condition of the rule which is applied first is recorded.
+func (s *uax14State) lookahead(cond lbCond, act lbAction) {
+	if s.cond == lbcond_none {
+		s.cond = cond
+		s.condAct = act
+	}
+}
+
+func (s *uax14State) update(r rune, c lbClass) {
+	s.zwj = (c == lbc_ZWJ)
+	if c == lbc_CM || c == lbc_ZWJ { // LB10
+		c = lbc_AL
+	}
+
+	p := s.prev
+	if c == lbc_SP {
+		if !s.spaces {
+			s.beforeSp = p
+		}
+		s.spaces = true
+	} else {
+		s.spaces = false
+		s.zwSp = (c == lbc_ZW)
+
+		s.piQu = false
+		if c == lbc_QU && unicode.Is(unicode.Pi, r) {
+			switch p {
+			case lbc_BK, lbc_CR, lbc_LF, lbc_NL, lbc_OP, lbc_QU, lbc_GL, lbc_SP,
+				lbc_ZW:
+				s.piQu = true
+			}
+		}
+	}
+
+	s.quHead = (c == lbc_QU && !isEastAsian(s.prevRune))
+
+	s.hyHead = false
+	if c == lbc_HY || c == lbc_HH {
+		switch p {
+		case lbc_BK, lbc_CR, lbc_LF, lbc_NL, lbc_SP, lbc_ZW, lbc_CB, lbc_GL:
+			s.hyHead = true
+		}
+	}
+
+	s.hlHy = (p == lbc_HL && (c == lbc_HY || c == lbc_HH))
+	s.numCl = (s.num && (c == lbc_CL || c == lbc_CP))
+	s.num = (c == lbc_NU || (s.num && (c == lbc_SY || c == lbc_IS)))
+	s.akVi = (c == lbc_VI && isAksara(p, s.prevRune))
+	s.riOdd = (c == lbc_RI && !(p == lbc_RI && s.riOdd))
+	s.prev = c
+	s.prevRune = r
+}
+
+func (s *uax14State) decide(r rune, c lbClass) (lbAction, bool) {
+	p := s.prev
+
+	// LB4, LB5
+	switch p {
+	case lbc_BK, lbc_LF, lbc_NL:
+		return lba_mandatory, false
+	case lbc_CR:
+		if c != lbc_LF {
+			return lba_mandatory, false
+		}
+		return lba_prohibited, false
+	}
+
+	switch c {
+	case lbc_BK, lbc_CR, lbc_LF, lbc_NL: // LB6
+		return lba_prohibited, false
+	case lbc_SP, lbc_ZW: // LB7
+		return lba_prohibited, false
+	}
+
+	if s.zwSp { // LB8
+		return lba_allowed, false
+	}
+
+	if c == lbc_CM || c == lbc_ZWJ {
+		if p != lbc_SP && p != lbc_ZW { // LB9
+			return lba_prohibited, true
+		}
+		c = lbc_AL // LB10
+	}
+
+	if s.zwj { // LB8a
+		return lba_prohibited, false
+	}
+
+	// LB11
+	if c == lbc_WJ || p == lbc_WJ {
+		return lba_prohibited, false
+	}
+
+	// LB12
+	if p == lbc_GL {
+		return lba_prohibited, false
+	}
+
+	// LB12a
+	if c == lbc_GL {
+		switch p {
+		case lbc_SP, lbc_BA, lbc_HY, lbc_HH:
+		default:
+			return lba_prohibited, false
+		}
+	}
+
+	// LB13
+	switch c {
+	case lbc_CL, lbc_CP, lbc_EX, lbc_SY:
+		return lba_prohibited, false
+	}
+
+	b := p
+	if s.spaces {
+		b = s.beforeSp
+	}
+
+	// LB14
+	if b == lbc_OP {
+		return lba_prohibited, false
+	}
+
+	// LB15a
+	if s.piQu {
+		return lba_prohibited, false
+	}
+
+	// LB15b
+	if c == lbc_QU && unicode.Is(unicode.Pf, r) {
+		s.lookahead(lbcond_lb15b, lba_prohibited)
+	}
+
+	// LB15c, LB15d
+	if c == lbc_IS {
+		if p == lbc_SP {
+			s.lookahead(lbcond_lb15c, lba_allowed)
+		}
+		return lba_prohibited, false
+	}
+
+	// LB16
+	if (b == lbc_CL || b == lbc_CP) && c == lbc_NS {
+		return lba_prohibited, false
+	}
+
+	// LB17
+	if b == lbc_B2 && c == lbc_B2 {
+		return lba_prohibited, false
+	}
+
+	// LB18
+	if p == lbc_SP {
+		return lba_allowed, false
+	}
+
+	// LB19
+	if c == lbc_QU && !unicode.Is(unicode.Pi, r) {
+		return lba_prohibited, false
+	}
+	if p == lbc_QU && !unicode.Is(unicode.Pf, s.prevRune) {
+		return lba_prohibited, false
+	}
+
+	// LB19a
+	if c == lbc_QU {
+		if !isEastAsian(s.prevRune) {
+			return lba_prohibited, false
+		}
+		s.lookahead(lbcond_lb19a, lba_prohibited)
+	}
+	if p == lbc_QU && (!isEastAsian(r) || s.quHead) {
+		return lba_prohibited, false
+	}
+
+	// LB20
+	if c == lbc_CB || p == lbc_CB {
+		return lba_allowed, false
+	}
+
+	// LB20a
+	if s.hyHead && (c == lbc_AL || c == lbc_HL) {
+		return lba_prohibited, false
+	}
+
+	// LB21
+	switch c {
+	case lbc_BA, lbc_HH, lbc_HY, lbc_NS:
+		return lba_prohibited, false
+	}
+	if p == lbc_BB {
+		return lba_prohibited, false
+	}
+
+	// LB21a
+	if s.hlHy && c != lbc_HL {
+		return lba_prohibited, false
+	}
+
+	// LB21b
+	if p == lbc_SY && c == lbc_HL {
+		return lba_prohibited, false
+	}
+
+	// LB22
+	if c == lbc_IN {
+		return lba_prohibited, false
+	}
+
+	if isLbPair(p, c, lbPairsNoBreak) { // LB23 - LB24, LB26 - LB28
+		return lba_prohibited, false
+	}
+
+	// LB25
+	if (s.num || s.numCl) && (c == lbc_PO || c == lbc_PR) {
+		return lba_prohibited, false
+	}
+	if (p == lbc_PO || p == lbc_PR) && c == lbc_OP {
+		s.lookahead(lbcond_lb25, lba_prohibited)
+	}
+	if c == lbc_NU {
+		switch p {
+		case lbc_PO, lbc_PR, lbc_HY, lbc_IS:
+			return lba_prohibited, false
+		}
+		if s.num {
+			return lba_prohibited, false
+		}
+	}
+
+	// LB28a
+	if p == lbc_AP && isAksara(c, r) {
+		return lba_prohibited, false
+	}
+	if isAksara(p, s.prevRune) && (c == lbc_VF || c == lbc_VI) {
+		return lba_prohibited, false
+	}
+	if s.akVi && (c == lbc_AK || r == dottedCircle) {
+		return lba_prohibited, false
+	}
+	if isAksara(p, s.prevRune) && isAksara(c, r) {
+		s.lookahead(lbcond_lb28a, lba_prohibited)
+	}
+
+	// LB29
+	if p == lbc_IS && (c == lbc_AL || c == lbc_HL) {
+		return lba_prohibited, false
+	}
+
+	// LB30
+	if (p == lbc_AL || p == lbc_HL || p == lbc_NU) && c == lbc_OP &&
+		!isEastAsian(r) {
+		return lba_prohibited, false
+	}
+	if p == lbc_CP && (c == lbc_AL || c == lbc_HL || c == lbc_NU) &&
+		!isEastAsian(s.prevRune) {
+		return lba_prohibited, false
+	}
+
+	// LB30a
+	if p == lbc_RI && c == lbc_RI && s.riOdd {
+		return lba_prohibited, false
+	}
+
+	// LB30b
+	if c == lbc_EM {
+		if p == lbc_EB {
+			return lba_prohibited, false
+		}
+		if isExtendedPictographic(s.prevRune) && !isAssigned(s.prevRune) {
+			return lba_prohibited, false
+		}
+	}
+
+	// LB31
+	return lba_allowed, false
+}
+
+// isAksara is the function that returns true if the specified rune of the
+// specified class is AK, AS or a dotted circle, which LB28a treats as a base.
+func isAksara(c lbClass, r rune) bool {
+	return c == lbc_AK || c == lbc_AS || r == dottedCircle
+}
+
+// resolveLbClass is the function that returns the line breaking class of the
+// specified rune, which is resolved by LB1.
+func resolveLbClass(r rune) lbClass {
+	c := lineBreakClass(r)
+	switch c {
+	case lbc_AI, lbc_SG, lbc_XX:
+		return lbc_AL
+	case lbc_SA:
+		if unicode.In(r, unicode.Mn, unicode.Mc) {
+			return lbc_CM
+		}
+		return lbc_AL
+	case lbc_CJ:
+		return lbc_NS
+	}
+	return c
+}
+
+type lbPair [2]lbClass
+
+// The pairs of classes between which a line break is prohibited by LB23 -
+// LB24 and LB26 - LB28.
+var lbPairsNoBreak = []lbPair{
+	// LB23
+	{lbc_AL, lbc_NU}, {lbc_HL, lbc_NU}, {lbc_NU, lbc_AL}, {lbc_NU, lbc_HL},
+	// LB23a
+	{lbc_PR, lbc_ID}, {lbc_PR, lbc_EB}, {lbc_PR, lbc_EM},
+	{lbc_ID, lbc_PO}, {lbc_EB, lbc_PO}, {lbc_EM, lbc_PO},
+	// LB24
+	{lbc_PR, lbc_AL}, {lbc_PR, lbc_HL}, {lbc_PO, lbc_AL}, {lbc_PO, lbc_HL},
+	{lbc_AL, lbc_PR}, {lbc_AL, lbc_PO}, {lbc_HL, lbc_PR}, {lbc_HL, lbc_PO},
+	// LB26
+	{lbc_JL, lbc_JL}, {lbc_JL, lbc_JV}, {lbc_JL, lbc_H2}, {lbc_JL, lbc_H3},
+	{lbc_JV, lbc_JV}, {lbc_JV, lbc_JT}, {lbc_H2, lbc_JV}, {lbc_H2, lbc_JT},
+	{lbc_JT, lbc_JT}, {lbc_H3, lbc_JT},
+	// LB27
+	{lbc_JL, lbc_PO}, {lbc_JV, lbc_PO}, {lbc_JT, lbc_PO}, {lbc_H2, lbc_PO},
+	{lbc_H3, lbc_PO}, {lbc_PR, lbc_JL}, {lbc_PR, lbc_JV}, {lbc_PR, lbc_JT},
+	{lbc_PR, lbc_H2}, {lbc_PR, lbc_H3},
+	// LB28
+	{lbc_AL, lbc_AL}, {lbc_AL, lbc_HL}, {lbc_HL, lbc_AL}, {lbc_HL, lbc_HL},
+}
+
+func isLbPair(p, c lbClass, pairs []lbPair) bool {
+	for _, pair := range pairs {
+		if pair[0] == p && pair[1] == c {
+			return true
+		}
+	}
+	return false
+}
+
+// find is the method to receive the specified rune, and to append the line
+// break opportunities which are determined by then to brks.
+func (s *uax14State) find(r rune, brks []brkType) []brkType {
+	s.next(r)
+	return s.popBrks(brks)
+}
+
+// flush is the method to append the line break opportunities which remain at
+// the end of text to brks.
+func (s *uax14State) flush(brks []brkType) []brkType {
+	s.end()
+	return s.popBrks(brks)
+}
+
+func (s *uax14State) popBrks(brks []brkType) []brkType {
+	for {
+		d, ok := s.pop()
+		if !ok {
+			return brks
+		}
+		brks = append(brks, s.brkOf(d))
+	}
+}
+
+// brkOf is the method that returns the line break opportunity before the rune
+// of the specified decision, which is tailored by the rules.
+func (s *uax14State) brkOf(d lbDecision) brkType {
+	r, prev := d.r, d.prev
+
+	switch d.raw {
+	case lbc_BK, lbc_CR, lbc_LF, lbc_NL:
+		return brk_mandatory
+	case lbc_SP:
+		return brk_space
+	}
+
+	if d.act == lba_prohibited {
+		return brk_never
+	}
+
+	// tailoring by the rules.
+	if s.rules.IsNoBreakBefore(r) || s.rules.IsNoBreakAfter(prev) ||
+		s.rules.keepsTogether(prev, r) {
+		return brk_never
+	}
+
+	// a hyphen at the head of a word, such as the hyphens of a command line
+	// flag, is kept with the following rune.
+	if d.leadHy {
+		return brk_never
+	}
+
+	before, after := s.rules.breaksAroundEmDash()
+	if (!before && contains(lboEmDashes, r)) ||
+		(!after && contains(lboEmDashes, prev)) {
+		return brk_never
+	}
+	return brk_allowed
+}
@@ -0,0 +1,106 @@
+package linebreak
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testLineBreakTestFile(t *testing.T, path string) {
+	f, err := os.Open(path)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if n == 1 {
+			assert.Equal(t, line, "# LineBreakTest-"+ucdVersion+".txt")
+		}
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[0:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		var runes []rune
+		var expected []string
+		for i := 1; i < len(fields)-1; i += 2 {
+			c, err := strconv.ParseUint(fields[i], 16, 32)
+			assert.Nil(t, err)
+			runes = append(runes, rune(c))
+			if i > 1 {
+				expected = append(expected, fields[i-1])
+			}
+		}
+
+		var s uax14State
+		for _, r := range runes {
+			s.next(r)
+		}
+		s.end()
+
+		var actual []string
+		for i := 0; ; i++ {
+			d, ok := s.pop()
+			if !ok {
+				break
+			}
+			if i == 0 {
+				continue
+			}
+			if d.act == lba_prohibited {
+				actual = append(actual, "×")
+			} else {
+				actual = append(actual, "÷")
+			}
+		}
+
+		assert.Equal(t, actual, expected, "%s:%d: %s", path, n, line)
+	}
+	assert.Nil(t, sc.Err())
+}
+
+// LineBreakTest.txt of the Unicode Character Database of the same version as
+// lbClassTable.
+func TestUax14State_lineBreakTest(t *testing.T) {
+	testLineBreakTestFile(t, "testdata/LineBreakTest.txt")
+}
+
+func TestLbClassTable_sorted(t *testing.T) {
+	for i := 1; i < len(lbClassTable); i++ {
+		assert.True(t, lbClassTable[i-1].last < lbClassTable[i].first)
+		assert.True(t, lbClassTable[i].first <= lbClassTable[i].last)
+	}
+}
+
+func TestLineBreakClass(t *testing.T) {
+	assert.Equal(t, lineBreakClass('a'), lbc_AL)
+	assert.Equal(t, lineBreakClass('1'), lbc_NU)
+	assert.Equal(t, lineBreakClass(' '), lbc_SP)
+	assert.Equal(t, lineBreakClass('漢'), lbc_ID)
+	assert.Equal(t, lineBreakClass('。'), lbc_CL)
+	assert.Equal(t, lineBreakClass('ぁ'), lbc_CJ)
+	assert.Equal(t, lineBreakClass('́'), lbc_CM)
+	assert.Equal(t, lineBreakClass('٣'), lbc_NU)
+	assert.Equal(t, lineBreakClass('한'), lbc_H3)
+	assert.Equal(t, lineBreakClass('가'), lbc_H2)
+	assert.Equal(t, lineBreakClass('ก'), lbc_SA)
+	assert.Equal(t, lineBreakClass(0x1F600), lbc_ID)
+	assert.Equal(t, lineBreakClass(0x1FFFD), lbc_ID)
+	assert.Equal(t, lineBreakClass(0xE000), lbc_XX)
+	assert.Equal(t, lineBreakClass(0x2010), lbc_HH)
+	assert.Equal(t, lineBreakClass(0x1B05), lbc_AK)
+	assert.Equal(t, lineBreakClass(0x1B50), lbc_AS)
+	assert.Equal(t, lineBreakClass(0x1B44), lbc_VI)
+	assert.Equal(t, lineBreakClass(0x1BF2), lbc_VF)
+	assert.Equal(t, lineBreakClass(0x11003), lbc_AP)
+}
@@ -6,10 +6,8 @@ package linebreak
 
 import (
 	"os"
-	"unicode"
 
 	"golang.org/x/term"
-	"golang.org/x/text/width"
 )
 
 // TermCols is the function that returns the column count of the current
@@ -44,19 +42,12 @@ func TermSize() (cols, rows int) {
 // rune.
 // A display width is determined by the Unicode Standard Annex #11 (UAX11)
 // East-Asian-Width.
+// Non-printable runes and non-spacing marks, which are combined with the
+// preceding rune, have no width.
+// The width of East-Asian-Width Ambiguous runes is 2. To measure them with
+// another width, use WidthMeasurer.
 func RuneWidth(r rune) int {
-	if !unicode.IsPrint(r) {
-		return 0
-	}
-
-	switch width.LookupRune(r).Kind() {
-	case width.EastAsianNarrow, width.EastAsianHalfwidth, width.Neutral:
-		return 1
-	case width.EastAsianWide, width.EastAsianFullwidth:
-		return 2
-	default: // width.EastAsianAmbiguous
-		return 2
-	}
+	return WidthMeasurer{}.RuneWidth(r)
 }
 
 // TextWidth is the function that returns the display width of the specified
@@ -64,10 +55,19 @@ func RuneWidth(r rune) int {
 // This function calculates the width of the text taking into account the
 // letter width determined by the Unicode Standard Annex #11 (UAX11)
 // East-Asian-Width.
+// The width is measured in units of extended grapheme clusters determined by
+// the Unicode Standard Annex #29 (UAX29), so that a letter with combining
+// marks or an emoji sequence is measured as one letter.
+// ANSI escape sequences in the text, such as SGR color sequences, have no
+// width.
+// The width of East-Asian-Width Ambiguous runes is 2. To measure them with
+// another width, use WidthMeasurer.
 func TextWidth(text string) int {
-	w := 0
-	for _, r := range text {
-		w += RuneWidth(r)
-	}
-	return w
+	return WidthMeasurer{}.TextWidth(text)
+}
+
+// clusterWidth is the function that returns the display width of the
+// specified extended grapheme cluster with the default width policy.
+func clusterWidth(cluster []rune) int {
+	return WidthMeasurer{}.clusterWidth(cluster)
 }
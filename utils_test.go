@@ -21,3 +21,19 @@ func TestTextWidth(t *testing.T) {
 	assert.Equal(t, linebreak.TextWidth("abc"), 3)
 	assert.Equal(t, linebreak.TextWidth("あいう"), 6)
 }
+
+func TestTextWidth_graphemeClusters(t *testing.T) {
+	assert.Equal(t, linebreak.TextWidth("e\u0301"), 1)
+	assert.Equal(t, linebreak.TextWidth("👨\u200d👩\u200d👧"), 2)
+	assert.Equal(t, linebreak.TextWidth("🇯🇵🇺🇸"), 4)
+	assert.Equal(t, linebreak.TextWidth("が"), 2)
+	assert.Equal(t, linebreak.TextWidth("か\u3099"), 2)
+}
+
+func TestTextWidth_escapeSequences(t *testing.T) {
+	assert.Equal(t, linebreak.TextWidth("\x1b[31mabc\x1b[0m"), 3)
+	assert.Equal(t, linebreak.TextWidth("\x1b]8;;http://example.com\x1b\\link\x1b]8;;\x1b\\"), 4)
+	assert.Equal(t, linebreak.TextWidth("\x1b(B\x1b[m"), 0)
+	assert.Equal(t, linebreak.TextWidth("\x1b(0qq\x1b(B"), 2)
+	assert.Equal(t, linebreak.TextWidth("\x1b#8\x1b%Gab"), 2)
+}
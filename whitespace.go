@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"unicode"
+)
+
+// WhitespaceMode is the enum type for the way to handle white spaces in the
+// text.
+type WhitespaceMode int
+
+const (
+	// WhitespaceTrim is the mode which removes the spaces at the head and the
+	// end of each line, and keeps the spaces between words.
+	// This is the default mode.
+	WhitespaceTrim WhitespaceMode = iota
+
+	// WhitespaceCollapse is the mode which replaces each run of spaces between
+	// words with a single space, and removes the spaces at the head and the
+	// end of each line.
+	WhitespaceCollapse
+
+	// WhitespacePreserve is the mode which keeps the spaces at the head of each
+	// paragraph, the spaces between words, and the spaces at the end of each
+	// line as long as they fit the line width.
+	WhitespacePreserve
+
+	// WhitespacePreserveLeading is the mode which keeps the spaces at the head
+	// of each paragraph, such as the indentation of a code block, and removes
+	// the spaces at the end of each line.
+	WhitespacePreserveLeading
+)
+
+// keepsLeading is the method that returns true if the spaces at the head of a
+// paragraph are kept in this mode.
+func (mode WhitespaceMode) keepsLeading() bool {
+	return mode == WhitespacePreserve || mode == WhitespacePreserveLeading
+}
+
+// keepTrailingSpaces is the function that returns the end index of the
+// content of a line extended over the trailing spaces which fit the limit.
+func keepTrailingSpaces(
+	runes []rune, widths []int, end, pos, limit int,
+) int {
+	w := 0
+	for i := 0; i < end; i++ {
+		if widths[i] > 0 {
+			w += widths[i]
+		}
+	}
+
+	for i := end; i < pos; i++ {
+		if widths[i] <= 0 {
+			continue
+		}
+		if !unicode.IsSpace(runes[i]) || w+widths[i] > limit {
+			break
+		}
+		w += widths[i]
+		end = unitEnd(widths, i)
+	}
+	return end
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// WidthMeasurer is the struct that measures the display widths of runes and
+// texts with a width policy.
+// AmbiguousWidth is the display width of runes of which East-Asian-Width is
+// Ambiguous, such as Greek and Cyrillic letters, box drawings and circled
+// digits. This width is 1 or 2, and the zero value is treated as 2, which is
+// the width of RuneWidth and TextWidth.
+type WidthMeasurer struct {
+	AmbiguousWidth int
+}
+
+// NewWidthMeasurer is the function that creates a WidthMeasurer instance
+// which measures East-Asian-Width Ambiguous runes with the specified width.
+func NewWidthMeasurer(ambiguousWidth int) WidthMeasurer {
+	return WidthMeasurer{AmbiguousWidth: ambiguousWidth}
+}
+
+// LocaleWidthMeasurer is the function that creates a WidthMeasurer instance
+// of which the width of East-Asian-Width Ambiguous runes is guessed from the
+// locale environment variables.
+func LocaleWidthMeasurer() WidthMeasurer {
+	return WidthMeasurer{AmbiguousWidth: GuessAmbiguousWidth()}
+}
+
+// GuessAmbiguousWidth is the function that guesses the display width of
+// East-Asian-Width Ambiguous runes from the locale environment variables:
+// LC_ALL, LC_CTYPE and LANG, in this order of priority.
+// This function returns 2 if the language of the locale is Chinese, Japanese
+// or Korean, otherwise returns 1.
+func GuessAmbiguousWidth() int {
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := os.Getenv(name); len(locale) > 0 {
+			return ambiguousWidthOfLocale(locale)
+		}
+	}
+	return 1
+}
+
+// ambiguousWidthOfLocale is the function that returns the display width of
+// East-Asian-Width Ambiguous runes in the specified locale, such as
+// "ja_JP.UTF-8".
+func ambiguousWidthOfLocale(locale string) int {
+	lang := strings.ToLower(locale)
+	if i := strings.IndexAny(lang, "_-.@"); i >= 0 {
+		lang = lang[0:i]
+	}
+	switch lang {
+	case "ja", "zh", "ko":
+		return 2
+	}
+	return 1
+}
+
+// RuneWidth is the method that returns the display width of the specified
+// rune.
+// A display width is determined by the Unicode Standard Annex #11 (UAX11)
+// East-Asian-Width and the AmbiguousWidth field.
+// Non-printable runes, except space separators such as a no-break space, and
+// non-spacing marks, which are combined with the preceding rune, have no
+// width.
+func (m WidthMeasurer) RuneWidth(r rune) int {
+	if !unicode.IsPrint(r) && !unicode.Is(unicode.Zs, r) ||
+		unicode.In(r, unicode.Mn, unicode.Me) {
+		return 0
+	}
+
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianNarrow, width.EastAsianHalfwidth, width.Neutral:
+		return 1
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	default: // width.EastAsianAmbiguous
+		if m.AmbiguousWidth == 1 {
+			return 1
+		}
+		return 2
+	}
+}
+
+// TextWidth is the method that returns the display width of the specified
+// text.
+// The width is measured in units of extended grapheme clusters determined by
+// the Unicode Standard Annex #29 (UAX29), and ANSI escape sequences in the
+// text have no width.
+func (m WidthMeasurer) TextWidth(text string) int {
+	w := 0
+	var gs graphemeState
+	var es escSeqState
+	cluster := make([]rune, 0, 8)
+	for _, r := range text {
+		if inSeq, _ := es.next(r); inSeq {
+			continue
+		}
+		if gs.next(r) && len(cluster) > 0 {
+			w += m.clusterWidth(cluster)
+			cluster = cluster[:0]
+		}
+		cluster = append(cluster, r)
+	}
+	if len(cluster) > 0 {
+		w += m.clusterWidth(cluster)
+	}
+	return w
+}
+
+// clusterWidth is the method that returns the display width of the specified
+// extended grapheme cluster.
+// The width of a cluster is the largest width of the runes in it, but a
+// cluster which is presented as an emoji, such as a cluster with
+// VARIATION SELECTOR-16 or a flag of regional indicators, has width 2.
+func (m WidthMeasurer) clusterWidth(cluster []rune) int {
+	if len(cluster) == 1 {
+		return m.RuneWidth(cluster[0])
+	}
+
+	w := 0
+	for i, r := range cluster {
+		if r == 0xFE0F { // VARIATION SELECTOR-16
+			return 2
+		}
+		if i == 1 && graphemeProperty(r) == gcb_RI &&
+			graphemeProperty(cluster[0]) == gcb_RI {
+			return 2
+		}
+		runeW := m.RuneWidth(r)
+		if runeW > w {
+			w = runeW
+		}
+	}
+	return w
+}
@@ -0,0 +1,67 @@
+package linebreak_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sttk/linebreak"
+)
+
+func TestWidthMeasurer_RuneWidth(t *testing.T) {
+	narrow := linebreak.NewWidthMeasurer(1)
+	assert.Equal(t, narrow.RuneWidth('a'), 1)
+	assert.Equal(t, narrow.RuneWidth('あ'), 2)
+	assert.Equal(t, narrow.RuneWidth('α'), 1)
+	assert.Equal(t, narrow.RuneWidth('─'), 1)
+	assert.Equal(t, narrow.RuneWidth('①'), 1)
+
+	wide := linebreak.NewWidthMeasurer(2)
+	assert.Equal(t, wide.RuneWidth('a'), 1)
+	assert.Equal(t, wide.RuneWidth('あ'), 2)
+	assert.Equal(t, wide.RuneWidth('α'), 2)
+	assert.Equal(t, wide.RuneWidth('─'), 2)
+	assert.Equal(t, wide.RuneWidth('①'), 2)
+
+	var zero linebreak.WidthMeasurer
+	assert.Equal(t, zero.RuneWidth('α'), 2)
+}
+
+func TestWidthMeasurer_TextWidth(t *testing.T) {
+	narrow := linebreak.NewWidthMeasurer(1)
+	assert.Equal(t, narrow.TextWidth("Привет, мир"), 11)
+	assert.Equal(t, narrow.TextWidth("αβγ日本"), 7)
+
+	wide := linebreak.NewWidthMeasurer(2)
+	assert.Equal(t, wide.TextWidth("Привет, мир"), 20)
+	assert.Equal(t, wide.TextWidth("αβγ日本"), 10)
+}
+
+func TestGuessAmbiguousWidth(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_CTYPE", "")
+	t.Setenv("LANG", "")
+	assert.Equal(t, linebreak.GuessAmbiguousWidth(), 1)
+
+	t.Setenv("LANG", "ja_JP.UTF-8")
+	assert.Equal(t, linebreak.GuessAmbiguousWidth(), 2)
+
+	t.Setenv("LC_CTYPE", "en_US.UTF-8")
+	assert.Equal(t, linebreak.GuessAmbiguousWidth(), 1)
+
+	t.Setenv("LC_ALL", "zh_CN.GB18030")
+	assert.Equal(t, linebreak.GuessAmbiguousWidth(), 2)
+
+	t.Setenv("LC_ALL", "ko")
+	assert.Equal(t, linebreak.GuessAmbiguousWidth(), 2)
+
+	t.Setenv("LC_ALL", "C")
+	assert.Equal(t, linebreak.GuessAmbiguousWidth(), 1)
+}
+
+func TestLocaleWidthMeasurer(t *testing.T) {
+	t.Setenv("LC_ALL", "de_DE.UTF-8")
+	assert.Equal(t, linebreak.LocaleWidthMeasurer().AmbiguousWidth, 1)
+
+	t.Setenv("LC_ALL", "ja_JP.UTF-8")
+	assert.Equal(t, linebreak.LocaleWidthMeasurer().AmbiguousWidth, 2)
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"strings"
+	"unicode"
+)
+
+// WrapLines is the function that breaks the given text into lines within the
+// specified width, and returns the lines.
+// The options are applied to the LineIter which breaks the text.
+func WrapLines(text string, lineWidth int, opts ...Option) []string {
+	iter := New(text, lineWidth, opts...)
+	return wrapLines(&iter)
+}
+
+func wrapLines(iter *LineIter) []string {
+	lines := []string{}
+	for iter.HasNext() {
+		line, _ := iter.Next()
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Wrap is the function that breaks the given text into lines within the
+// specified width, and returns the lines joined with "\n".
+// The options are applied to the LineIter which breaks the text.
+func Wrap(text string, lineWidth int, opts ...Option) string {
+	return strings.Join(WrapLines(text, lineWidth, opts...), "\n")
+}
+
+// Fill is the function that refills the paragraphs of the given text within
+// the specified width, and returns the lines joined with "\n".
+// Unlike Wrap, the line breaks in a paragraph are replaced with spaces, and
+// only blank lines are kept as paragraph separators, so that a text which has
+// been wrapped already can be rewrapped with another width.
+// The options are applied to the LineIter which breaks the text.
+func Fill(text string, lineWidth int, opts ...Option) string {
+	return Wrap(unwrapParagraphs(text), lineWidth, opts...)
+}
+
+// unwrapParagraphs is the function that joins the lines in each paragraph of
+// the given text with spaces. Paragraphs are separated by blank lines.
+func unwrapParagraphs(text string) string {
+	var sb strings.Builder
+	sb.Grow(len(text))
+
+	inPara := false
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+		if len(line) == 0 {
+			if inPara {
+				sb.WriteByte('\n')
+			}
+			sb.WriteByte('\n')
+			inPara = false
+			continue
+		}
+		if inPara {
+			sb.WriteByte(' ')
+			line = strings.TrimLeftFunc(line, unicode.IsSpace)
+		}
+		sb.WriteString(line)
+		inPara = true
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
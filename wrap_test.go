@@ -0,0 +1,58 @@
+package linebreak_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sttk/linebreak"
+)
+
+func TestWrapLines(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog.\nThe end."
+	lines := linebreak.WrapLines(text, 16)
+	assert.Equal(t, lines, []string{
+		"The quick brown",
+		"fox jumps over",
+		"the lazy dog.",
+		"The end.",
+	})
+}
+
+func TestWrapLines_withOptions(t *testing.T) {
+	text := "-v, --verbose  Print the names of files as they are processed."
+	lines := linebreak.WrapLines(text, 30,
+		linebreak.WithContinuationIndent("               "),
+	)
+	assert.Equal(t, lines, []string{
+		"-v, --verbose  Print the names",
+		"               of files as",
+		"               they are",
+		"               processed.",
+	})
+}
+
+func TestWrapLines_withAmbiguousWidth(t *testing.T) {
+	text := "αβγ δεζ ηθι"
+
+	lines := linebreak.WrapLines(text, 7)
+	assert.Equal(t, lines, []string{"αβγ", "δεζ", "ηθι"})
+
+	lines = linebreak.WrapLines(text, 7, linebreak.WithAmbiguousWidth(1))
+	assert.Equal(t, lines, []string{"αβγ δεζ", "ηθι"})
+}
+
+func TestWrapLines_empty(t *testing.T) {
+	assert.Equal(t, linebreak.WrapLines("", 10), []string{""})
+}
+
+func TestWrap(t *testing.T) {
+	text := "The quick brown fox jumps over the lazy dog.\n"
+	assert.Equal(t, linebreak.Wrap(text, 16, linebreak.WithIndent("  ")),
+		"  The quick\n  brown fox\n  jumps over the\n  lazy dog.\n")
+}
+
+func TestFill(t *testing.T) {
+	text := "The quick brown\nfox jumps over\n  the lazy dog.\n\n\nThe end.\n"
+	assert.Equal(t, linebreak.Fill(text, 24),
+		"The quick brown fox\njumps over the lazy dog.\n\n\nThe end.\n")
+}
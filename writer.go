@@ -0,0 +1,309 @@
+// Copyright (C) 2023 Takayuki Sato. All Rights Reserved.
+// This program is free software under MIT License.
+// See the file LICENSE in this distribution for more details.
+
+package linebreak
+
+import (
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// ErrWriterClosed is the error which is returned when writing to a Writer
+// which has been closed.
+var ErrWriterClosed = errors.New("linebreak: write to closed Writer")
+
+// Writer is the struct that wraps the text written to it with the same rules
+// as LineIter, and writes the wrapped lines to the underlying io.Writer.
+// The text can be written in arbitrary chunks, even if a chunk ends in the
+// middle of a UTF-8 sequence.
+// Since a line is determined after reading the following runes, the last
+// line is written when Flush or Close is called.
+type Writer struct {
+	w       io.Writer
+	iter    LineIter
+	newline string
+	keepSep bool
+	partial []byte // the incomplete UTF-8 sequence at the end of the last chunk
+	err     error
+	written bool
+	closed  bool
+}
+
+// NewWriter is the function that creates a Writer instance which writes the
+// wrapped lines to the given io.Writer.
+// The second arguument is the width of the output lines, and the options are
+// applied to the LineIter which wraps the text.
+func NewWriter(w io.Writer, lineWidth int, opts ...Option) *Writer {
+	return &Writer{
+		w:       w,
+		iter:    New("", lineWidth, opts...),
+		newline: "\n",
+	}
+}
+
+// SetNewline is the method to set the string which is written at the end of
+// each line. The default newline is "\n".
+func (w *Writer) SetNewline(newline string) {
+	w.newline = newline
+}
+
+// SetKeepSeparators is the method to set whether a line ended by a line
+// separator in the text, such as "\r\n" or "\u2029", is written with the
+// separator instead of the newline.
+// A line broken by wrapping is written with the newline.
+func (w *Writer) SetKeepSeparators(on bool) {
+	w.keepSep = on
+}
+
+// SetIndent is the method to set an indentation for the subsequent lines.
+// See LineIter.SetIndent.
+func (w *Writer) SetIndent(indent string) {
+	w.iter.SetIndent(indent)
+}
+
+// SetFirstIndent is the method to set an indentation for the first line.
+// See LineIter.SetFirstIndent.
+func (w *Writer) SetFirstIndent(indent string) {
+	w.iter.SetFirstIndent(indent)
+}
+
+// SetContinuationIndent is the method to set an indentation for the lines
+// which follow a line broken by wrapping.
+// See LineIter.SetContinuationIndent.
+func (w *Writer) SetContinuationIndent(indent string) {
+	w.iter.SetContinuationIndent(indent)
+}
+
+// SetParagraphIndent is the method to set an indentation for the lines which
+// follow a mandatory line break.
+// See LineIter.SetParagraphIndent.
+func (w *Writer) SetParagraphIndent(indent string) {
+	w.iter.SetParagraphIndent(indent)
+}
+
+// SetAlignment is the method to set the alignment of the subsequent lines.
+// See LineIter.SetAlignment.
+func (w *Writer) SetAlignment(align Alignment) {
+	w.iter.SetAlignment(align)
+}
+
+// SetHyphenator is the method to set a Hyphenator to hyphenate a word which
+// overflows a line.
+// See LineIter.SetHyphenator.
+func (w *Writer) SetHyphenator(h *Hyphenator) {
+	w.iter.SetHyphenator(h)
+}
+
+// SetForcedBreakHyphen is the method to set whether a hyphen is put at the end
+// of a line which is broken forcely in a word.
+// See LineIter.SetForcedBreakHyphen.
+func (w *Writer) SetForcedBreakHyphen(on bool) {
+	w.iter.SetForcedBreakHyphen(on)
+}
+
+// SetFillMode is the method to set the way to choose line breaks in a
+// paragraph.
+// In FillOptimal mode, the lines of a paragraph are written when the
+// paragraph ends or Flush is called.
+// See LineIter.SetFillMode.
+func (w *Writer) SetFillMode(mode FillMode) {
+	w.iter.SetFillMode(mode)
+}
+
+// SetPenalties is the method to set the penalties which are used to choose
+// line breaks in FillOptimal mode.
+// See LineIter.SetPenalties.
+func (w *Writer) SetPenalties(p Penalties) {
+	w.iter.SetPenalties(p)
+}
+
+// SetTabMode is the method to set the way to handle horizontal tabs in the
+// text.
+// See LineIter.SetTabMode.
+func (w *Writer) SetTabMode(mode TabMode) {
+	w.iter.SetTabMode(mode)
+}
+
+// SetTabWidth is the method to set the interval of tab stops.
+// See LineIter.SetTabWidth.
+func (w *Writer) SetTabWidth(width int) {
+	w.iter.SetTabWidth(width)
+}
+
+// SetWhitespaceMode is the method to set the way to handle white spaces in
+// the text.
+// See LineIter.SetWhitespaceMode.
+func (w *Writer) SetWhitespaceMode(mode WhitespaceMode) {
+	w.iter.SetWhitespaceMode(mode)
+}
+
+// SetPrefixFunc is the method to set a function which returns a prefix of
+// each line.
+// See LineIter.SetPrefixFunc.
+func (w *Writer) SetPrefixFunc(fn func(info LineInfo) string) {
+	w.iter.SetPrefixFunc(fn)
+}
+
+// SetSuffixFunc is the method to set a function which returns a suffix of
+// each line.
+// See LineIter.SetSuffixFunc.
+func (w *Writer) SetSuffixFunc(fn func(info LineInfo) string) {
+	w.iter.SetSuffixFunc(fn)
+}
+
+// SetBreakAlgorithm is the method to set the algorithm to find line break
+// opportunities.
+// This method should be called before the first call of Write.
+func (w *Writer) SetBreakAlgorithm(alg BreakAlgorithm) {
+	w.iter.SetBreakAlgorithm(alg)
+}
+
+// SetRules is the method to set the rules which tailor line break
+// opportunities.
+// See LineIter.SetRules.
+func (w *Writer) SetRules(rules Rules) {
+	w.iter.SetRules(rules)
+}
+
+// SetLinkAware is the method to enable or disable the link aware mode.
+// See LineIter.SetLinkAware.
+func (w *Writer) SetLinkAware(on bool) {
+	w.iter.SetLinkAware(on)
+}
+
+// SetKinsokuStrategy is the method to set the way to avoid a rune which
+// cannot be at the head of a line.
+// See LineIter.SetKinsokuStrategy.
+func (w *Writer) SetKinsokuStrategy(strategy KinsokuStrategy) {
+	w.iter.SetKinsokuStrategy(strategy)
+}
+
+// SetWidthMeasurer is the method to set the WidthMeasurer which measures the
+// display widths of the indentation and the runes in the text.
+// This method should be called before the first call of Write.
+func (w *Writer) SetWidthMeasurer(m WidthMeasurer) {
+	w.iter.SetWidthMeasurer(m)
+}
+
+// Write is the method to write the specified bytes as a part of the text.
+// The lines which are determined by the bytes are written to the underlying
+// io.Writer.
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, ErrWriterClosed
+	}
+	if w.err != nil {
+		return 0, w.err
+	}
+
+	b := p
+	if len(w.partial) > 0 {
+		b = append(w.partial, p...)
+		w.partial = nil
+	}
+
+	for len(b) > 0 {
+		if !utf8.FullRune(b) {
+			w.partial = append(make([]byte, 0, utf8.UTFMax), b...)
+			break
+		}
+		r, size := utf8.DecodeRune(b)
+		b = b[size:]
+		if err := w.feed(r, size); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush is the method to write the rest of the text, which has been written
+// but not output yet, to the underlying io.Writer.
+// The last line is output with a newline, and the text written after this
+// method is started from a new line.
+func (w *Writer) Flush() error {
+	if w.closed {
+		return ErrWriterClosed
+	}
+	if w.err != nil {
+		return w.err
+	}
+
+	if len(w.partial) > 0 {
+		size := len(w.partial)
+		w.partial = nil
+		if err := w.feed(utf8.RuneError, size); err != nil {
+			return err
+		}
+	}
+
+	for {
+		lineNo, paraNo := w.iter.lineNo, w.iter.paraNo
+		line := w.iter.flush(w.iter.lineLimit())
+		if w.iter.isEnd {
+			if w.iter.isEmpty {
+				// the empty last line is not output and is not counted.
+				w.iter.lineNo, w.iter.paraNo = lineNo, paraNo
+				w.iter.popLineMeta()
+			} else if err := w.writeLine(line); err != nil {
+				return err
+			}
+			break
+		}
+		if err := w.writeLines(line); err != nil {
+			return err
+		}
+	}
+
+	w.iter.reset()
+	if w.written {
+		w.iter.lineKind = line_paragraph
+	}
+	return nil
+}
+
+// Close is the method to flush the rest of the text and to close this Writer.
+// This method does not close the underlying io.Writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	err := w.Flush()
+	w.closed = true
+	return err
+}
+
+func (w *Writer) feed(r rune, size int) error {
+	if line, exists := w.iter.feed(r, size, w.iter.lineLimit()); exists {
+		return w.writeLines(line)
+	}
+	return nil
+}
+
+// writeLines is the method to write the specified line and the following
+// lines which are already determined.
+func (w *Writer) writeLines(line string) error {
+	for exists := true; exists; {
+		if err := w.writeLine(line); err != nil {
+			return err
+		}
+		line, exists = w.iter.pendingLine(w.iter.lineLimit())
+	}
+	return nil
+}
+
+func (w *Writer) writeLine(line string) error {
+	w.iter.popLineMeta()
+	newline := w.newline
+	if sep := w.iter.Separator(); w.keepSep && len(sep) > 0 {
+		newline = sep
+	}
+	if _, err := io.WriteString(w.w, line+newline); err != nil {
+		w.err = err
+		return err
+	}
+	w.written = true
+	return nil
+}
@@ -0,0 +1,231 @@
+package linebreak_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sttk/linebreak"
+)
+
+func TestWriter_Write(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 20)
+
+	n, err := fmt.Fprintf(w, "%s %s", "Lorem ipsum dolor sit amet,", "consectetur")
+	assert.Nil(t, err)
+	assert.Equal(t, n, 39)
+	assert.Equal(t, buf.String(), "Lorem ipsum dolor\n")
+
+	n, err = w.Write([]byte(" adipiscing elit.\nSed do"))
+	assert.Nil(t, err)
+	assert.Equal(t, n, 24)
+	assert.Equal(t, buf.String(), "Lorem ipsum dolor\nsit amet,\nconsectetur\nadipiscing elit.\n")
+
+	assert.Nil(t, w.Close())
+	assert.Equal(t, buf.String(), "Lorem ipsum dolor\nsit amet,\nconsectetur\nadipiscing elit.\nSed do\n")
+}
+
+func TestWriter_Write_splitUtf8Sequence(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 6)
+
+	b := []byte("あいうえお")
+	for i := range b {
+		n, err := w.Write(b[i : i+1])
+		assert.Nil(t, err)
+		assert.Equal(t, n, 1)
+	}
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, buf.String(), "あいう\nえお\n")
+}
+
+func TestWriter_Write_longWord(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 4)
+
+	_, err := w.Write([]byte("a abcdefghij b"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, buf.String(), "a\nabcd\nefgh\nij b\n")
+}
+
+func TestWriter_SetNewlineAndIndent(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 10)
+	w.SetNewline("\r\n")
+	w.SetIndent("  ")
+
+	_, err := w.Write([]byte("abc def ghi\n\njkl"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, buf.String(), "  abc def\r\n  ghi\r\n\r\n  jkl\r\n")
+}
+
+func TestWriter_Flush(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 10)
+
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, buf.String(), "")
+
+	_, err := w.Write([]byte("abc\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, buf.String(), "abc\n")
+
+	_, err = w.Write([]byte("def"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, buf.String(), "abc\ndef\n")
+
+	_, err = w.Write([]byte("ghi\xe3\x81"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, buf.String(), "abc\ndef\nghi�\n")
+}
+
+func TestWriter_Close(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 10)
+
+	_, err := w.Write([]byte("abc"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.Equal(t, buf.String(), "abc\n")
+
+	assert.Nil(t, w.Close())
+
+	n, err := w.Write([]byte("def"))
+	assert.Equal(t, n, 0)
+	assert.Equal(t, err, linebreak.ErrWriterClosed)
+	assert.Equal(t, w.Flush(), linebreak.ErrWriterClosed)
+}
+
+type errWriter struct{ err error }
+
+func (w errWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
+
+func TestWriter_Write_error(t *testing.T) {
+	e := errors.New("write error")
+	w := linebreak.NewWriter(errWriter{e}, 4)
+
+	n, err := w.Write([]byte("abc def ghi"))
+	assert.Equal(t, n, 0)
+	assert.Equal(t, err, e)
+
+	n, err = w.Write([]byte("jkl"))
+	assert.Equal(t, n, 0)
+	assert.Equal(t, err, e)
+
+	assert.Equal(t, w.Close(), e)
+}
+
+func TestWriter_SetFirstIndent(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 12)
+	w.SetFirstIndent("-a  ")
+	w.SetContinuationIndent("    ")
+	w.SetParagraphIndent("  ")
+
+	_, err := w.Write([]byte("abc def ghi jkl\nmno"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	_, err = w.Write([]byte("pqr"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.Equal(t, buf.String(), "-a  abc def\n    ghi jkl\n  mno\n  pqr\n")
+}
+
+func TestWriter_SetPrefixFunc(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 12)
+	w.SetPrefixFunc(func(info linebreak.LineInfo) string {
+		return fmt.Sprintf("%d| // ", info.LineNumber)
+	})
+
+	_, err := w.Write([]byte("abc def ghi\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	_, err = w.Write([]byte("jkl"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.Equal(t, buf.String(), "1| // abc\n2| // def\n3| // ghi\n4| // jkl\n")
+}
+
+func TestWriter_SetHyphenator(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 10)
+	w.SetHyphenator(linebreak.EnglishHyphenator())
+
+	_, err := w.Write([]byte("the information\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.Equal(t, buf.String(), "the infor-\nmation\n")
+}
+
+func TestWriter_SetFillMode(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 6)
+	w.SetFillMode(linebreak.FillOptimal)
+	w.SetPenalties(linebreak.Penalties{})
+
+	_, err := w.Write([]byte("aaa bb cc ddddd\naaa bb cc"))
+	assert.Nil(t, err)
+	assert.Equal(t, buf.String(), "aaa\nbb cc\nddddd\n")
+	assert.Nil(t, w.Close())
+	assert.Equal(t, buf.String(), "aaa\nbb cc\nddddd\naaa bb\ncc\n")
+}
+
+func TestWriter_SetTabMode(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 20)
+	w.SetTabMode(linebreak.TabExpand)
+	w.SetTabWidth(4)
+
+	_, err := w.Write([]byte("a\tbc\tdef\tg"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.Equal(t, buf.String(), "a   bc  def g\n")
+}
+
+func TestWriter_SetWhitespaceMode(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 20)
+	w.SetWhitespaceMode(linebreak.WhitespacePreserveLeading)
+
+	_, err := w.Write([]byte("  code\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	_, err = w.Write([]byte("    more code"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.Equal(t, buf.String(), "  code\n    more code\n")
+}
+
+func TestWriter_SetKeepSeparators(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 5)
+	w.SetKeepSeparators(true)
+
+	_, err := w.Write([]byte("abc def\r"))
+	assert.Nil(t, err)
+	_, err = w.Write([]byte("\ngh\u2029ij"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.Equal(t, buf.String(), "abc\ndef\r\ngh\u2029ij\n")
+}
+
+func TestWriter_SetKinsokuStrategy(t *testing.T) {
+	var buf bytes.Buffer
+	w := linebreak.NewWriter(&buf, 12)
+	w.SetKinsokuStrategy(linebreak.KinsokuBurasage)
+	_, err := w.Write([]byte("置かないのだ。次の文。"))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, buf.String(), "置かないのだ。\n次の文。\n")
+}